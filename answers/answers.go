@@ -0,0 +1,161 @@
+// Package answers 维护已被接受的谜题答案记录。
+//
+// 每条记录以 (day, part, 输入哈希) 为键：不同账号的输入不同，答案也不同，
+// 所以答案只对产生它的那份输入有效。记录保存在一个纯文本文件中，
+// 每行一条，便于在代码评审里查看差异：
+//
+//	# day part input-sha256 answer
+//	1 1 5c8e…e1a2 1341714
+//
+// 空行和以 # 开头的行会被忽略；答案中不能包含空白字符。
+package answers
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultFile 是仓库根目录下答案文件的默认文件名。
+const DefaultFile = "answers.txt"
+
+const header = "# day part input-sha256 answer\n"
+
+// Key 唯一标识一条答案记录。
+type Key struct {
+	Day, Part int
+	InputHash string
+}
+
+// HashInput 返回输入内容的 SHA-256 十六进制摘要，作为答案记录的键。
+func HashInput(input []byte) string {
+	sum := sha256.Sum256(input)
+	return hex.EncodeToString(sum[:])
+}
+
+// Book 是内存中的答案记录集合。
+type Book struct {
+	answers map[Key]string
+}
+
+// New 返回一个空的 Book。
+func New() *Book {
+	return &Book{answers: make(map[Key]string)}
+}
+
+// Parse 从 r 读取答案文件。格式错误时返回带行号的错误。
+func Parse(r io.Reader) (*Book, error) {
+	b := New()
+	sc := bufio.NewScanner(r)
+	for lineNo := 1; sc.Scan(); lineNo++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("answers: line %d: want 4 fields (day part hash answer), got %d", lineNo, len(fields))
+		}
+		day, errDay := strconv.Atoi(fields[0])
+		part, errPart := strconv.Atoi(fields[1])
+		if errDay != nil || errPart != nil {
+			return nil, fmt.Errorf("answers: line %d: day and part must be numbers", lineNo)
+		}
+		if err := b.Record(Key{Day: day, Part: part, InputHash: fields[2]}, fields[3]); err != nil {
+			return nil, fmt.Errorf("answers: line %d: %w", lineNo, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("answers: %w", err)
+	}
+	return b, nil
+}
+
+// Load 读取 path 处的答案文件；文件不存在时返回空的 Book。
+func Load(path string) (*Book, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return New(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Lookup 返回 k 对应的已记录答案。
+func (b *Book) Lookup(k Key) (string, bool) {
+	answer, ok := b.answers[k]
+	return answer, ok
+}
+
+// Record 记录（或覆盖）k 对应的答案。
+func (b *Book) Record(k Key, answer string) error {
+	switch {
+	case k.Day < 1 || k.Day > 25 || k.Part < 1 || k.Part > 2:
+		return fmt.Errorf("invalid day %d part %d", k.Day, k.Part)
+	case k.InputHash == "" || strings.ContainsFunc(k.InputHash, isSpace):
+		return fmt.Errorf("invalid input hash %q", k.InputHash)
+	case answer == "" || strings.ContainsFunc(answer, isSpace):
+		return fmt.Errorf("answer %q must be non-empty and contain no whitespace", answer)
+	}
+	b.answers[k] = answer
+	return nil
+}
+
+// Len 返回记录条数。
+func (b *Book) Len() int {
+	return len(b.answers)
+}
+
+// WriteTo 按 (day, part, hash) 排序写出所有记录，输出可被 Parse 读回。
+func (b *Book) WriteTo(w io.Writer) (int64, error) {
+	keys := make([]Key, 0, len(b.answers))
+	for k := range b.answers {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, c := keys[i], keys[j]
+		if a.Day != c.Day {
+			return a.Day < c.Day
+		}
+		if a.Part != c.Part {
+			return a.Part < c.Part
+		}
+		return a.InputHash < c.InputHash
+	})
+
+	var sb strings.Builder
+	sb.WriteString(header)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "%d %d %s %s\n", k.Day, k.Part, k.InputHash, b.answers[k])
+	}
+	n, err := io.WriteString(w, sb.String())
+	return int64(n), err
+}
+
+// Save 把所有记录写入 path。
+func (b *Book) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := b.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
@@ -0,0 +1,129 @@
+package answers
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHashInput(t *testing.T) {
+	// sha256("")
+	const empty = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	if got := HashInput(nil); got != empty {
+		t.Errorf("HashInput(nil) = %s, want %s", got, empty)
+	}
+	if HashInput([]byte("a")) == HashInput([]byte("b")) {
+		t.Error("different inputs hash to the same key")
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantLen int
+		wantErr string
+	}{
+		{
+			name:    "comments and blank lines",
+			input:   "# header\n\n1 1 abc 11\n1 2 abc 31\n23 2 def bv,cm,dk\n",
+			wantLen: 3,
+		},
+		{name: "empty", input: "", wantLen: 0},
+		{name: "too few fields", input: "1 1 abc\n", wantErr: "line 1"},
+		{name: "bad day", input: "# x\nx 1 abc 1\n", wantErr: "line 2"},
+		{name: "day out of range", input: "26 1 abc 1\n", wantErr: "invalid day"},
+		{name: "part out of range", input: "1 3 abc 1\n", wantErr: "invalid day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := Parse(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want it to mention %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b.Len() != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", b.Len(), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	b := New()
+	k := Key{Day: 1, Part: 1, InputHash: "abc"}
+
+	if err := b.Record(k, "has space"); err == nil {
+		t.Error("answer with whitespace was accepted")
+	}
+	if err := b.Record(Key{Day: 1, Part: 1}, "1"); err == nil {
+		t.Error("empty input hash was accepted")
+	}
+	if err := b.Record(k, "11"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Record(k, "12"); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := b.Lookup(k); !ok || got != "12" {
+		t.Errorf("Lookup = %q, %v; want overwritten answer 12", got, ok)
+	}
+	if _, ok := b.Lookup(Key{Day: 1, Part: 1, InputHash: "other"}); ok {
+		t.Error("answer leaked to a different input hash")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	b := New()
+	for _, rec := range []struct {
+		k      Key
+		answer string
+	}{
+		{Key{23, 2, "ff"}, "bv,cm"},
+		{Key{1, 2, "aa"}, "31"},
+		{Key{1, 1, "bb"}, "12"},
+		{Key{1, 1, "aa"}, "11"},
+	} {
+		if err := b.Record(rec.k, rec.answer); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if _, err := b.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := header + "1 1 aa 11\n1 1 bb 12\n1 2 aa 31\n23 2 ff bv,cm\n"
+	if buf.String() != want {
+		t.Errorf("WriteTo =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	path := filepath.Join(t.TempDir(), DefaultFile)
+	if err := b.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := loaded.Lookup(Key{23, 2, "ff"}); !ok || got != "bv,cm" {
+		t.Errorf("Lookup after Load = %q, %v", got, ok)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	b, err := Load(filepath.Join(t.TempDir(), "nope.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", b.Len())
+	}
+}
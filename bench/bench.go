@@ -0,0 +1,156 @@
+// Package bench 测量求解函数的运行时间和内存使用，生成 JSON/CSV 报告，
+// 并与保存的基线比较以发现性能回退。
+package bench
+
+import (
+	"cmp"
+	"fmt"
+	"runtime"
+	"runtime/metrics"
+	"slices"
+	"sync"
+	"time"
+
+	"adventofcode/registry"
+)
+
+// Result 是一个 (day, part) 多次运行的统计。
+type Result struct {
+	Day          int    `json:"day"`
+	Part         int    `json:"part"`
+	Runs         int    `json:"runs"`
+	MinNS        int64  `json:"min_ns"`
+	MedianNS     int64  `json:"median_ns"`
+	MeanNS       int64  `json:"mean_ns"`
+	AllocsPerRun uint64 `json:"allocs_per_run"`
+	BytesPerRun  uint64 `json:"bytes_per_run"`
+	// PeakHeapBytes 是运行期间采样到的堆上存活对象的最大字节数。
+	PeakHeapBytes uint64 `json:"peak_heap_bytes"`
+}
+
+// Median 以 time.Duration 返回中位运行时间。
+func (r Result) Median() time.Duration { return time.Duration(r.MedianNS) }
+
+// Report 是一次基准测试的完整报告。
+type Report struct {
+	GoVersion string    `json:"go_version"`
+	GOOS      string    `json:"goos"`
+	GOARCH    string    `json:"goarch"`
+	Time      time.Time `json:"time"`
+	Results   []Result  `json:"results"`
+}
+
+// NewReport 返回带有当前运行环境信息的空报告。
+func NewReport() *Report {
+	return &Report{
+		GoVersion: runtime.Version(),
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		Time:      time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// Lookup 返回报告中 (day, part) 的结果。
+func (r *Report) Lookup(day, part int) (Result, bool) {
+	for _, res := range r.Results {
+		if res.Day == day && res.Part == part {
+			return res, true
+		}
+	}
+	return Result{}, false
+}
+
+// sampleInterval 是采样堆大小的间隔。
+const sampleInterval = time.Millisecond
+
+// Measure 用同一份输入运行 solve runs 次。任一次运行出错时返回该错误。
+// 每次运行前都会先做一次 GC，使分配统计和堆峰值不受之前运行的影响。
+func Measure(day, part int, solve registry.Solver, input string, runs int) (Result, error) {
+	if runs < 1 {
+		return Result{}, fmt.Errorf("bench: runs must be positive, got %d", runs)
+	}
+	durations := make([]time.Duration, runs)
+	var totalAllocs, totalBytes, peak uint64
+	for i := range runs {
+		runtime.GC()
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+
+		stop := samplePeakHeap()
+		start := time.Now()
+		_, err := solve(input)
+		durations[i] = time.Since(start)
+		p := stop()
+
+		runtime.ReadMemStats(&after)
+		if err != nil {
+			return Result{}, err
+		}
+		totalAllocs += after.Mallocs - before.Mallocs
+		totalBytes += after.TotalAlloc - before.TotalAlloc
+		peak = max(peak, p)
+	}
+
+	slices.Sort(durations)
+	var sum time.Duration
+	for _, d := range durations {
+		sum += d
+	}
+	return Result{
+		Day:           day,
+		Part:          part,
+		Runs:          runs,
+		MinNS:         int64(durations[0]),
+		MedianNS:      int64(durations[runs/2]),
+		MeanNS:        int64(sum) / int64(runs),
+		AllocsPerRun:  totalAllocs / uint64(runs),
+		BytesPerRun:   totalBytes / uint64(runs),
+		PeakHeapBytes: peak,
+	}, nil
+}
+
+// heapMetric 是堆上存活（及尚未回收）对象占用的字节数。
+// 与 runtime.ReadMemStats 不同，读取它不需要暂停所有 goroutine。
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// samplePeakHeap 在后台定期采样堆大小，调用返回的函数停止采样并得到峰值。
+func samplePeakHeap() (stop func() uint64) {
+	sample := []metrics.Sample{{Name: heapMetric}}
+	read := func() uint64 {
+		metrics.Read(sample)
+		if sample[0].Value.Kind() != metrics.KindUint64 {
+			return 0
+		}
+		return sample[0].Value.Uint64()
+	}
+
+	peak := read()
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		t := time.NewTicker(sampleInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				peak = max(peak, read())
+			}
+		}
+	}()
+	return func() uint64 {
+		close(done)
+		wg.Wait()
+		return max(peak, read())
+	}
+}
+
+// sortResults 按 day、part 升序排列结果。
+func sortResults(results []Result) {
+	slices.SortFunc(results, func(a, b Result) int {
+		return cmp.Or(cmp.Compare(a.Day, b.Day), cmp.Compare(a.Part, b.Part))
+	})
+}
@@ -0,0 +1,122 @@
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var sink []byte
+
+func TestMeasure(t *testing.T) {
+	const size = 8 << 20
+	solve := func(input string) (string, error) {
+		sink = make([]byte, size)
+		for i := range sink {
+			sink[i] = byte(i)
+		}
+		return input, nil
+	}
+
+	res, err := Measure(3, 1, solve, "x", 3)
+	sink = nil
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Day != 3 || res.Part != 1 || res.Runs != 3 {
+		t.Errorf("Result = %+v", res)
+	}
+	if res.MinNS <= 0 || res.MinNS > res.MedianNS {
+		t.Errorf("min %d, median %d", res.MinNS, res.MedianNS)
+	}
+	if res.AllocsPerRun < 1 || res.BytesPerRun < size {
+		t.Errorf("allocs %d, bytes %d; want at least 1 allocation of %d bytes", res.AllocsPerRun, res.BytesPerRun, size)
+	}
+	if res.PeakHeapBytes < size {
+		t.Errorf("PeakHeapBytes = %d, want at least %d", res.PeakHeapBytes, size)
+	}
+}
+
+func TestMeasureErrors(t *testing.T) {
+	boom := errors.New("boom")
+	if _, err := Measure(1, 1, func(string) (string, error) { return "", boom }, "", 2); !errors.Is(err, boom) {
+		t.Errorf("err = %v, want %v", err, boom)
+	}
+	if _, err := Measure(1, 1, func(string) (string, error) { return "", nil }, "", 0); err == nil {
+		t.Error("runs = 0 was accepted")
+	}
+}
+
+func sampleReport() *Report {
+	r := NewReport()
+	r.Results = []Result{
+		{Day: 6, Part: 2, Runs: 3, MinNS: 900, MedianNS: 1000, MeanNS: 1000, AllocsPerRun: 50, BytesPerRun: 4096, PeakHeapBytes: 8192},
+		{Day: 1, Part: 1, Runs: 3, MinNS: 10, MedianNS: 12, MeanNS: 12, AllocsPerRun: 2},
+	}
+	return r
+}
+
+func TestReportRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sampleReport().WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Results) != 2 || got.Results[0].Day != 1 {
+		t.Fatalf("Results = %+v, want 2 results sorted by day", got.Results)
+	}
+	if res, ok := got.Lookup(6, 2); !ok || res.PeakHeapBytes != 8192 {
+		t.Errorf("Lookup(6, 2) = %+v, %v", res, ok)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sampleReport().WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "day,part,runs,min_ns,median_ns,mean_ns,allocs_per_run,bytes_per_run,peak_heap_bytes\n" +
+		"1,1,3,10,12,12,2,0,0\n" +
+		"6,2,3,900,1000,1000,50,4096,8192\n"
+	if buf.String() != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := sampleReport()
+
+	tests := []struct {
+		name   string
+		result Result
+		want   []string // 期望出现回退的指标
+	}{
+		{name: "within threshold", result: Result{Day: 6, Part: 2, MedianNS: 1190, AllocsPerRun: 50}},
+		{name: "slower", result: Result{Day: 6, Part: 2, MedianNS: 1500, AllocsPerRun: 50}, want: []string{"median_ns"}},
+		{name: "more allocations", result: Result{Day: 6, Part: 2, MedianNS: 800, AllocsPerRun: 100}, want: []string{"allocs_per_run"}},
+		{name: "not in baseline", result: Result{Day: 9, Part: 1, MedianNS: 1 << 40}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := &Report{Results: []Result{tt.result}}
+			regs := Compare(baseline, current, 0.2)
+			var got []string
+			for _, r := range regs {
+				got = append(got, r.Metric)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("regressions = %v, want %v", regs, tt.want)
+			}
+		})
+	}
+
+	regs := Compare(baseline, &Report{Results: []Result{{Day: 6, Part: 2, MedianNS: 1500, AllocsPerRun: 50}}}, 0.2)
+	if want := "day 6 part 2: median_ns 1000 -> 1500 (+50.0%)"; len(regs) != 1 || regs[0].String() != want {
+		t.Errorf("String() = %v, want %q", regs, want)
+	}
+}
@@ -0,0 +1,46 @@
+package bench
+
+import "fmt"
+
+// Regression 描述一项比基线差超过阈值的指标。
+type Regression struct {
+	Day, Part int
+	Metric    string // "median_ns" 或 "allocs_per_run"
+	Baseline  float64
+	Current   float64
+}
+
+// Ratio 返回当前值相对基线的倍数。
+func (r Regression) Ratio() float64 {
+	return r.Current / r.Baseline
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("day %d part %d: %s %.0f -> %.0f (%+.1f%%)",
+		r.Day, r.Part, r.Metric, r.Baseline, r.Current, (r.Ratio()-1)*100)
+}
+
+// Compare 比较 current 与 baseline 中都有的 (day, part)，返回中位时间或
+// 每次运行的分配次数比基线增加超过 threshold（例如 0.2 表示 20%）的项。
+// 基线中没有的条目不参与比较。
+func Compare(baseline, current *Report, threshold float64) []Regression {
+	var regs []Regression
+	for _, cur := range current.Results {
+		base, ok := baseline.Lookup(cur.Day, cur.Part)
+		if !ok {
+			continue
+		}
+		for _, m := range []struct {
+			name      string
+			base, cur float64
+		}{
+			{"median_ns", float64(base.MedianNS), float64(cur.MedianNS)},
+			{"allocs_per_run", float64(base.AllocsPerRun), float64(cur.AllocsPerRun)},
+		} {
+			if m.base > 0 && m.cur > m.base*(1+threshold) {
+				regs = append(regs, Regression{Day: cur.Day, Part: cur.Part, Metric: m.name, Baseline: m.base, Current: m.cur})
+			}
+		}
+	}
+	return regs
+}
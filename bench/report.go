@@ -0,0 +1,85 @@
+package bench
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// WriteJSON 把报告写成缩进的 JSON。
+func (r *Report) WriteJSON(w io.Writer) error {
+	sortResults(r.Results)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// csvHeader 与 Result 的 JSON 字段名一致。
+var csvHeader = []string{"day", "part", "runs", "min_ns", "median_ns", "mean_ns", "allocs_per_run", "bytes_per_run", "peak_heap_bytes"}
+
+// WriteCSV 把报告中的结果写成 CSV，每个 (day, part) 一行。
+func (r *Report) WriteCSV(w io.Writer) error {
+	sortResults(r.Results)
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, res := range r.Results {
+		row := []string{
+			strconv.Itoa(res.Day),
+			strconv.Itoa(res.Part),
+			strconv.Itoa(res.Runs),
+			strconv.FormatInt(res.MinNS, 10),
+			strconv.FormatInt(res.MedianNS, 10),
+			strconv.FormatInt(res.MeanNS, 10),
+			strconv.FormatUint(res.AllocsPerRun, 10),
+			strconv.FormatUint(res.BytesPerRun, 10),
+			strconv.FormatUint(res.PeakHeapBytes, 10),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadJSON 读取 WriteJSON 写出的报告。
+func ReadJSON(r io.Reader) (*Report, error) {
+	var rep Report
+	if err := json.NewDecoder(r).Decode(&rep); err != nil {
+		return nil, fmt.Errorf("bench: %w", err)
+	}
+	return &rep, nil
+}
+
+// Load 读取 path 处的 JSON 报告。
+func Load(path string) (*Report, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadJSON(f)
+}
+
+// Save 按扩展名把报告写到 path：.csv 写 CSV，其他写 JSON。
+func (r *Report) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	write := r.WriteJSON
+	if filepath.Ext(path) == ".csv" {
+		write = r.WriteCSV
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,246 @@
+package circuit
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"slices"
+)
+
+// AdderError 描述加法器算错的一次加法。
+type AdderError struct {
+	X, Y, Got, Want *big.Int
+	// Bit 是结果中最低的错误位。
+	Bit int
+}
+
+func (e *AdderError) Error() string {
+	return fmt.Sprintf("circuit: %v + %v gives %v, want %v (lowest wrong bit z%02d)", e.X, e.Y, e.Got, e.Want, e.Bit)
+}
+
+// AdderTests 返回验证 width 位加法器 z = x + y 的测试用例。
+//
+// 对每一位 i，都覆盖本位两个输入与低位进位的全部 8 种组合：没有进位时
+// 单独置 x_i、y_i 或同时置位；有进位时在低 i 位放 "全 1 加 1"，让进位从
+// 第 0 位一路传到第 i 位。此外还有全 0、两个全 1，以及一批固定种子的随机数。
+func AdderTests(width int) [][2]*big.Int {
+	one := big.NewInt(1)
+	bit := func(i int) *big.Int { return new(big.Int).Lsh(one, uint(i)) }
+	ones := func(n int) *big.Int { return new(big.Int).Sub(bit(n), one) }
+	or := func(a, b *big.Int) *big.Int { return new(big.Int).Or(a, b) }
+
+	zero := new(big.Int)
+	tests := [][2]*big.Int{{zero, zero}, {ones(width), ones(width)}}
+	for i := range width {
+		tests = append(tests,
+			[2]*big.Int{bit(i), zero},
+			[2]*big.Int{zero, bit(i)},
+			[2]*big.Int{bit(i), bit(i)},
+		)
+		if i > 0 {
+			tests = append(tests,
+				[2]*big.Int{ones(i), one},
+				[2]*big.Int{ones(i + 1), one},
+				[2]*big.Int{ones(i), or(bit(i), one)},
+				[2]*big.Int{ones(i + 1), or(bit(i), one)},
+			)
+		}
+	}
+	rng := rand.New(rand.NewSource(int64(width)))
+	limit := bit(width)
+	for range 128 {
+		tests = append(tests, [2]*big.Int{new(big.Int).Rand(rng, limit), new(big.Int).Rand(rng, limit)})
+	}
+	return tests
+}
+
+// CheckAdder 用 tests 检验电路是否是 width 位加法器：输入总线 x、y 各 width 位，
+// 输出总线 z 为 x + y。电路除 x、y 外不能有其他输入。全部通过时返回 nil，
+// 否则返回最低错误位最小的那次失败（*AdderError）。
+//
+// 测试以位并行的方式运行：每根导线用一个 uint64 同时保存 64 个用例的值。
+func CheckAdder(c *Circuit, width int, tests [][2]*big.Int) error {
+	if name, ok := foreignInput(c, width); ok {
+		return fmt.Errorf("circuit: %s is not an input of a %d-bit adder", name, width)
+	}
+
+	var worst *AdderError
+	values := make([]uint64, len(c.names))
+	for start := 0; start < len(tests); start += 64 {
+		batch := tests[start:min(start+64, len(tests))]
+		c.evalBatch(values, batch)
+
+		// 逐位比较，找出本批中最低的错误位及出错的用例。
+		sums := make([]*big.Int, len(batch))
+		for lane, t := range batch {
+			sums[lane] = new(big.Int).Add(t[0], t[1])
+		}
+		z := c.buses["z"]
+		for bit := range width + 1 {
+			if worst != nil && bit >= worst.Bit {
+				break
+			}
+			var got, want uint64
+			if bit < len(z) && z[bit] >= 0 {
+				got = values[z[bit]]
+			}
+			for lane, s := range sums {
+				want |= uint64(s.Bit(bit)) << lane
+			}
+			if diff := got ^ want; diff != 0 {
+				lane := 0
+				for diff&(1<<lane) == 0 {
+					lane++
+				}
+				worst = &AdderError{X: batch[lane][0], Y: batch[lane][1], Want: sums[lane], Got: laneValue(values, z, lane), Bit: bit}
+				break
+			}
+		}
+		// 比 width 更高的 z 位必须为 0。
+		for bit := width + 1; bit < len(z) && (worst == nil || bit < worst.Bit); bit++ {
+			if z[bit] >= 0 && values[z[bit]] != 0 {
+				lane := 0
+				for values[z[bit]]&(1<<lane) == 0 {
+					lane++
+				}
+				worst = &AdderError{X: batch[lane][0], Y: batch[lane][1], Want: sums[lane], Got: laneValue(values, z, lane), Bit: bit}
+			}
+		}
+	}
+	if worst != nil {
+		return worst
+	}
+	return nil
+}
+
+// PassingTests 返回 tests 中电路算对 x + y 的用例个数。电路的输入不是
+// width 位加法器的输入时返回 0。
+func PassingTests(c *Circuit, width int, tests [][2]*big.Int) int {
+	if _, ok := foreignInput(c, width); ok {
+		return 0
+	}
+	passed := 0
+	values := make([]uint64, len(c.names))
+	for start := 0; start < len(tests); start += 64 {
+		batch := tests[start:min(start+64, len(tests))]
+		c.evalBatch(values, batch)
+		for lane, t := range batch {
+			if laneValue(values, c.buses["z"], lane).Cmp(new(big.Int).Add(t[0], t[1])) == 0 {
+				passed++
+			}
+		}
+	}
+	return passed
+}
+
+// foreignInput 返回电路中第一个不属于 width 位 x、y 总线的输入导线。
+func foreignInput(c *Circuit, width int) (string, bool) {
+	for _, name := range c.Inputs() {
+		if prefix, bit, ok := busBit(name); !ok || (prefix != "x" && prefix != "y") || bit >= width {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// evalBatch 把一批（最多 64 个）用例装入 values 的各个通道，然后位并行求值。
+func (c *Circuit) evalBatch(values []uint64, batch [][2]*big.Int) {
+	clear(values)
+	for lane, t := range batch {
+		for k, prefix := range []string{"x", "y"} {
+			for bit, i := range c.buses[prefix] {
+				if i >= 0 && t[k].Bit(bit) == 1 {
+					values[i] |= 1 << lane
+				}
+			}
+		}
+	}
+	c.evalWords(values)
+}
+
+// laneValue 取出位并行求值中第 lane 个用例的总线值。
+func laneValue(values []uint64, bus []int, lane int) *big.Int {
+	v := new(big.Int)
+	for bit, i := range bus {
+		if i >= 0 && values[i]&(1<<lane) != 0 {
+			v.SetBit(v, bit, 1)
+		}
+	}
+	return v
+}
+
+// evalWords 按拓扑顺序位并行地求值所有门，values 按导线编号保存。
+func (c *Circuit) evalWords(values []uint64) {
+	for _, g := range c.order {
+		a, b := values[g.a], values[g.b]
+		switch g.op {
+		case And:
+			values[g.out] = a & b
+		case Or:
+			values[g.out] = a | b
+		default:
+			values[g.out] = a ^ b
+		}
+	}
+}
+
+// Suspects 按行波进位加法器的结构规则，找出 width 位加法器中输出可疑的门，
+// 返回这些门的输出导线（排序后）。规则是：
+//
+//   - 除最高位外，z 只能由 XOR 门驱动；最高位 z 是最后的进位，只能由 OR 门驱动；
+//   - 输入不是 x、y 的 XOR 门是求和门，输出必须是 z；
+//   - x_i XOR y_i（i > 0）是半加的和，必须再进入一个 XOR 门；
+//   - x_i AND y_i 与进位 AND 门（第 0 位除外）的输出必须进入一个 OR 门。
+//
+// 第 0 位是半加器，没有进位输入，因此 x00、y00 的门不适用最后两条规则。
+func Suspects(gates []Gate, width int) []string {
+	type use struct {
+		wire string
+		op   Op
+	}
+	used := make(map[use]bool)
+	for _, g := range gates {
+		used[use{g.A, g.Op}] = true
+		used[use{g.B, g.Op}] = true
+	}
+	isInput := func(g Gate) bool {
+		prefix, _, ok := busBit(g.A)
+		return ok && (prefix == "x" || prefix == "y")
+	}
+	isBit0 := func(g Gate) bool {
+		_, a, _ := busBit(g.A)
+		_, b, _ := busBit(g.B)
+		return isInput(g) && a == 0 && b == 0
+	}
+
+	var suspects []string
+	for _, g := range gates {
+		prefix, bit, isBus := busBit(g.Out)
+		isZ := isBus && prefix == "z"
+		var bad bool
+		switch {
+		case isZ && bit == width && width > 1:
+			bad = g.Op != Or
+		case isZ && bit < width:
+			bad = g.Op != Xor
+		}
+		switch g.Op {
+		case Xor:
+			if !isInput(g) && !isZ {
+				bad = true
+			}
+			if isInput(g) && !isBit0(g) && !used[use{g.Out, Xor}] {
+				bad = true
+			}
+		case And:
+			if !isBit0(g) && !used[use{g.Out, Or}] {
+				bad = true
+			}
+		}
+		if bad {
+			suspects = append(suspects, g.Out)
+		}
+	}
+	slices.Sort(suspects)
+	return slices.Compact(suspects)
+}
@@ -0,0 +1,328 @@
+// Package circuit 模拟第 24 天那种由 AND、OR、XOR 门组成的组合逻辑电路。
+//
+// New 对逻辑门做一次拓扑排序，之后每次模拟都按这个顺序求值一遍即可；
+// 电路中有环时返回 *CycleError，指出环上的导线。形如 "x00"、"z45" 的导线
+// （字母前缀加十进制位号）组成总线，可以用 math/big 整数整体读写，
+// 因此位宽不受 64 位的限制。
+package circuit
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Op 是逻辑门的类型。
+type Op int
+
+const (
+	And Op = iota
+	Or
+	Xor
+)
+
+var opNames = [...]string{"AND", "OR", "XOR"}
+
+func (op Op) String() string {
+	if op < 0 || int(op) >= len(opNames) {
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
+	return opNames[op]
+}
+
+// ParseOp 返回门名（"AND"、"OR"、"XOR"）对应的类型。
+func ParseOp(s string) (Op, bool) {
+	i := slices.Index(opNames[:], s)
+	return Op(i), i >= 0
+}
+
+// Apply 返回门对输入 a、b 的输出。
+func (op Op) Apply(a, b bool) bool {
+	switch op {
+	case And:
+		return a && b
+	case Or:
+		return a || b
+	}
+	return a != b
+}
+
+// Gate 是一个逻辑门：Out = A Op B。
+type Gate struct {
+	A, B string
+	Op   Op
+	Out  string
+}
+
+func (g Gate) String() string {
+	return fmt.Sprintf("%s %v %s -> %s", g.A, g.Op, g.B, g.Out)
+}
+
+// CycleError 表示电路中有环。Wires 是环上的导线，按信号传播的顺序排列，
+// 最后一根导线又驱动第一根导线所在的门。
+type CycleError struct {
+	Wires []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("circuit: cycle through wires %s -> %s", strings.Join(e.Wires, " -> "), e.Wires[0])
+}
+
+// gate 是用导线编号表示的逻辑门。
+type gate struct {
+	a, b, out int
+	op        Op
+}
+
+// Circuit 是拓扑排序后的电路，创建后不再改变，可以被多个 State 并发使用。
+type Circuit struct {
+	gates  []Gate // 按拓扑顺序
+	order  []gate // 与 gates 一一对应
+	names  []string
+	index  map[string]int
+	driver []int // 每根导线由哪个门（order 中的下标）驱动，-1 表示是输入
+	buses  map[string][]int
+}
+
+// New 对 gates 做拓扑排序并返回电路。一根导线被多个门驱动、或者两根导线
+// 是同一总线的同一位（如 x7 和 x07）时返回错误，有环时返回 *CycleError。
+func New(gates []Gate) (*Circuit, error) {
+	c := &Circuit{index: make(map[string]int), buses: make(map[string][]int)}
+	wire := func(name string) int {
+		if i, ok := c.index[name]; ok {
+			return i
+		}
+		c.index[name] = len(c.names)
+		c.names = append(c.names, name)
+		c.driver = append(c.driver, -1)
+		return len(c.names) - 1
+	}
+
+	indexed := make([]gate, len(gates))
+	for i, g := range gates {
+		indexed[i] = gate{a: wire(g.A), b: wire(g.B), out: wire(g.Out), op: g.Op}
+		if d := c.driver[indexed[i].out]; d >= 0 {
+			return nil, fmt.Errorf("circuit: wire %s is driven by both %v and %v", g.Out, gates[d], g)
+		}
+		c.driver[indexed[i].out] = i
+	}
+
+	// Kahn 算法：pending[i] 是门 i 尚未求值的输入数。
+	pending := make([]int, len(gates))
+	readers := make([][]int, len(c.names)) // 以该导线为输入的门
+	for i, g := range indexed {
+		for _, in := range []int{g.a, g.b} {
+			if c.driver[in] >= 0 {
+				pending[i]++
+			}
+			readers[in] = append(readers[in], i)
+		}
+	}
+	var queue []int
+	for i := range indexed {
+		if pending[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		c.gates = append(c.gates, gates[i])
+		c.order = append(c.order, indexed[i])
+		for _, r := range readers[indexed[i].out] {
+			if pending[r]--; pending[r] == 0 {
+				queue = append(queue, r)
+			}
+		}
+	}
+	if len(c.order) < len(gates) {
+		return nil, &CycleError{Wires: c.findCycle(indexed, pending)}
+	}
+
+	// 新的驱动门下标指向排序后的 order。
+	for i, g := range c.order {
+		c.driver[g.out] = i
+	}
+	for i, name := range c.names {
+		if prefix, bit, ok := busBit(name); ok {
+			bus := c.buses[prefix]
+			for len(bus) <= bit {
+				bus = append(bus, -1)
+			}
+			if bus[bit] >= 0 {
+				return nil, fmt.Errorf("circuit: wires %s and %s are both bit %d of bus %s", c.names[bus[bit]], name, bit, prefix)
+			}
+			bus[bit] = i
+			c.buses[prefix] = bus
+		}
+	}
+	return c, nil
+}
+
+// findCycle 在拓扑排序剩下的门（pending > 0）中找出一个环。
+// 剩下的每个门都至少有一个输入由另一个剩下的门驱动，所以沿着这样的输入
+// 往回走，必然会回到走过的门。
+func (c *Circuit) findCycle(gates []gate, pending []int) []string {
+	start := slices.IndexFunc(pending, func(n int) bool { return n > 0 })
+	seen := make(map[int]int) // 门 → 在 path 中的位置
+	var path []int
+	for i := start; ; {
+		if at, ok := seen[i]; ok {
+			path = path[at:]
+			break
+		}
+		seen[i] = len(path)
+		path = append(path, i)
+		g := gates[i]
+		if d := c.driver[g.a]; d >= 0 && pending[d] > 0 {
+			i = d
+		} else {
+			i = c.driver[g.b]
+		}
+	}
+	// path 是逆着信号方向走的，翻转后依次列出每个门的输出。
+	slices.Reverse(path)
+	wires := make([]string, len(path))
+	for k, i := range path {
+		wires[k] = c.names[gates[i].out]
+	}
+	return wires
+}
+
+// busBit 把 "x07" 形式的导线名拆成前缀和位号。位号按数值解析，"x7" 也是第 7 位。
+func busBit(name string) (prefix string, bit int, ok bool) {
+	i := strings.IndexFunc(name, func(r rune) bool { return r >= '0' && r <= '9' })
+	if i <= 0 {
+		return "", 0, false
+	}
+	bit, err := strconv.Atoi(name[i:])
+	if err != nil || strings.IndexFunc(name[:i], func(r rune) bool { return r < 'a' || r > 'z' }) >= 0 {
+		return "", 0, false
+	}
+	return name[:i], bit, true
+}
+
+// Gates 按拓扑顺序返回所有门：每个门的输入都由排在它前面的门或电路输入驱动。
+func (c *Circuit) Gates() []Gate {
+	return slices.Clone(c.gates)
+}
+
+// Wires 返回所有导线名，按首次出现的顺序。
+func (c *Circuit) Wires() []string {
+	return slices.Clone(c.names)
+}
+
+// Inputs 返回不由任何门驱动的导线，即模拟前必须赋值的导线，按名字排序。
+func (c *Circuit) Inputs() []string {
+	var inputs []string
+	for i, name := range c.names {
+		if c.driver[i] < 0 {
+			inputs = append(inputs, name)
+		}
+	}
+	slices.Sort(inputs)
+	return inputs
+}
+
+// Driver 返回驱动导线 wire 的门；wire 是输入或不存在时返回 false。
+func (c *Circuit) Driver(wire string) (Gate, bool) {
+	i, ok := c.index[wire]
+	if !ok || c.driver[i] < 0 {
+		return Gate{}, false
+	}
+	return c.gates[c.driver[i]], true
+}
+
+// Width 返回总线 prefix 的位宽，即最高位号加 1；没有这条总线时返回 0。
+func (c *Circuit) Width(prefix string) int {
+	return len(c.buses[prefix])
+}
+
+// ErrUnset 表示模拟时某根输入导线没有赋值。
+var ErrUnset = errors.New("circuit: input wire has no value")
+
+// State 保存一次模拟中各导线的值。零值不可用，请用 Circuit.NewState 创建。
+type State struct {
+	c      *Circuit
+	values []bool
+	set    []bool
+}
+
+// NewState 返回一个所有导线都未赋值的模拟状态。
+func (c *Circuit) NewState() *State {
+	return &State{c: c, values: make([]bool, len(c.names)), set: make([]bool, len(c.names))}
+}
+
+// Set 给导线 wire 赋值。只能给输入导线赋值，门的输出由 Run 计算。
+func (s *State) Set(wire string, v bool) error {
+	i, ok := s.c.index[wire]
+	if !ok {
+		return fmt.Errorf("circuit: unknown wire %s", wire)
+	}
+	if s.c.driver[i] >= 0 {
+		return fmt.Errorf("circuit: wire %s is driven by %v and cannot be set", wire, s.c.gates[s.c.driver[i]])
+	}
+	s.values[i], s.set[i] = v, true
+	return nil
+}
+
+// SetBus 把 v 的各个二进制位赋给总线 prefix 的各根导线，第 i 位对应
+// prefix 后接位号 i 的导线（谜题写成两位，如 x00）。v 超出总线位宽或为负数时返回错误。
+func (s *State) SetBus(prefix string, v *big.Int) error {
+	bus := s.c.buses[prefix]
+	if v.Sign() < 0 {
+		return fmt.Errorf("circuit: negative value %v for bus %s", v, prefix)
+	}
+	if v.BitLen() > len(bus) {
+		return fmt.Errorf("circuit: value %v needs %d bits but bus %s has %d", v, v.BitLen(), prefix, len(bus))
+	}
+	for bit, i := range bus {
+		if i < 0 {
+			if v.Bit(bit) != 0 {
+				return fmt.Errorf("circuit: bus %s has no wire for bit %d", prefix, bit)
+			}
+			continue
+		}
+		if err := s.Set(s.c.names[i], v.Bit(bit) == 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run 按拓扑顺序求值所有门。有输入导线未赋值时返回包装了 ErrUnset 的错误。
+func (s *State) Run() error {
+	for i, name := range s.c.names {
+		if s.c.driver[i] < 0 && !s.set[i] {
+			return fmt.Errorf("%w: %s", ErrUnset, name)
+		}
+	}
+	for _, g := range s.c.order {
+		s.values[g.out] = g.op.Apply(s.values[g.a], s.values[g.b])
+		s.set[g.out] = true
+	}
+	return nil
+}
+
+// Get 返回导线 wire 的值；导线不存在或还没有值时第二个返回值为 false。
+func (s *State) Get(wire string) (v, ok bool) {
+	i, ok := s.c.index[wire]
+	if !ok || !s.set[i] {
+		return false, false
+	}
+	return s.values[i], true
+}
+
+// Bus 把总线 prefix 各根导线的值组合成整数，缺失或没有值的位视为 0。
+func (s *State) Bus(prefix string) *big.Int {
+	v := new(big.Int)
+	for bit, i := range s.c.buses[prefix] {
+		if i >= 0 && s.values[i] && s.set[i] {
+			v.SetBit(v, bit, 1)
+		}
+	}
+	return v
+}
@@ -0,0 +1,176 @@
+package circuit
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// rippleAdder 返回 width 位的行波进位加法器，结构与谜题输入相同：
+// z = x + y，共 width+1 位输出。
+func rippleAdder(width int) []Gate {
+	var gates []Gate
+	carry := ""
+	for i := range width {
+		x, y, z := fmt.Sprintf("x%02d", i), fmt.Sprintf("y%02d", i), fmt.Sprintf("z%02d", i)
+		xor, and := fmt.Sprintf("xor%02d", i), fmt.Sprintf("and%02d", i)
+		if i == 0 {
+			gates = append(gates, Gate{x, y, Xor, z}, Gate{x, y, And, and})
+			carry = and
+			continue
+		}
+		through, next := fmt.Sprintf("thr%02d", i), fmt.Sprintf("car%02d", i)
+		gates = append(gates,
+			Gate{x, y, Xor, xor},
+			Gate{x, y, And, and},
+			Gate{xor, carry, Xor, z},
+			Gate{xor, carry, And, through},
+			Gate{and, through, Or, next},
+		)
+		carry = next
+	}
+	// 最高位的进位直接作为 z 的最高位：把最后一个 OR 门的输出改名
+	last := &gates[len(gates)-1]
+	if width == 1 {
+		last = &gates[1]
+	}
+	last.Out = fmt.Sprintf("z%02d", width)
+	return gates
+}
+
+func TestAdder(t *testing.T) {
+	rng := rand.New(rand.NewSource(24))
+	for _, width := range []int{1, 4, 45, 64, 100} {
+		gates := rippleAdder(width)
+		rng.Shuffle(len(gates), func(i, j int) { gates[i], gates[j] = gates[j], gates[i] })
+		c, err := New(gates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Width("x") != width || c.Width("z") != width+1 {
+			t.Fatalf("width %d: buses x=%d z=%d", width, c.Width("x"), c.Width("z"))
+		}
+
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(width))
+		max := new(big.Int).Sub(limit, big.NewInt(1))
+		pairs := [][2]*big.Int{{big.NewInt(0), big.NewInt(0)}, {max, big.NewInt(1)}, {max, max}}
+		for range 20 {
+			x := new(big.Int).Rand(rng, limit)
+			y := new(big.Int).Rand(rng, limit)
+			pairs = append(pairs, [2]*big.Int{x, y})
+		}
+		for _, p := range pairs {
+			s := c.NewState()
+			if err := s.SetBus("x", p[0]); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.SetBus("y", p[1]); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.Run(); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := s.Bus("z"), new(big.Int).Add(p[0], p[1]); got.Cmp(want) != 0 {
+				t.Errorf("width %d: %v + %v = %v, want %v", width, p[0], p[1], got, want)
+			}
+		}
+	}
+}
+
+func TestTopologicalOrder(t *testing.T) {
+	gates := []Gate{
+		{"c", "d", Or, "z00"},
+		{"x00", "b", And, "c"},
+		{"x00", "y00", Xor, "b"},
+		{"y00", "b", Xor, "d"},
+	}
+	c, err := New(gates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ready := map[string]bool{"x00": true, "y00": true}
+	for _, g := range c.Gates() {
+		if !ready[g.A] || !ready[g.B] {
+			t.Fatalf("%v comes before its inputs in %v", g, c.Gates())
+		}
+		ready[g.Out] = true
+	}
+	if got := c.Inputs(); !slices.Equal(got, []string{"x00", "y00"}) {
+		t.Errorf("Inputs() = %v", got)
+	}
+	if g, ok := c.Driver("c"); !ok || g != gates[1] {
+		t.Errorf("Driver(c) = %v, %v", g, ok)
+	}
+	if _, ok := c.Driver("x00"); ok {
+		t.Error("Driver(x00) found a gate for an input")
+	}
+}
+
+func TestCycle(t *testing.T) {
+	gates := []Gate{
+		{"x00", "y00", And, "a"},
+		{"a", "d", Or, "b"},
+		{"b", "x00", Xor, "c"},
+		{"c", "y00", And, "d"},
+		{"d", "a", Xor, "z00"},
+	}
+	_, err := New(gates)
+	var cycle *CycleError
+	if !errors.As(err, &cycle) {
+		t.Fatalf("New() = %v, want *CycleError", err)
+	}
+	// 环是 b -> c -> d -> b，从哪根导线开始都可以
+	got := strings.Join(cycle.Wires, ",")
+	if got != "b,c,d" && got != "c,d,b" && got != "d,b,c" {
+		t.Errorf("cycle wires = %v, want b, c, d in signal order", cycle.Wires)
+	}
+	if !strings.Contains(err.Error(), " -> ") {
+		t.Errorf("error %q does not show the cycle", err)
+	}
+
+	if _, err := New([]Gate{{"a", "a", And, "a"}}); !errors.As(err, &cycle) || len(cycle.Wires) != 1 {
+		t.Errorf("self loop: %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := New([]Gate{{"x00", "y00", And, "z00"}, {"x00", "y00", Or, "z00"}}); err == nil {
+		t.Error("New() with two drivers for z00 succeeded")
+	}
+	if _, err := New([]Gate{{"x0", "y00", And, "z00"}, {"x00", "y00", Or, "z01"}}); err == nil || !strings.Contains(err.Error(), "both bit 0 of bus x") {
+		t.Errorf("New() with x0 and x00 = %v, want a duplicate bit error", err)
+	}
+
+	c, err := New([]Gate{{"x00", "y00", And, "z00"}, {"x01", "y01", And, "z01"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := c.NewState()
+	if err := s.SetBus("x", big.NewInt(4)); err == nil {
+		t.Error("SetBus() with a value wider than the bus succeeded")
+	}
+	if err := s.Set("z00", true); err == nil {
+		t.Error("Set() of a gate output succeeded")
+	}
+	if err := s.Set("q", true); err == nil {
+		t.Error("Set() of an unknown wire succeeded")
+	}
+	s.SetBus("x", big.NewInt(3))
+	if err := s.Run(); !errors.Is(err, ErrUnset) {
+		t.Errorf("Run() with unset y = %v, want ErrUnset", err)
+	}
+	s.SetBus("y", big.NewInt(2))
+	if err := s.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Bus("z"); got.Int64() != 2 {
+		t.Errorf("z = %v, want 2", got)
+	}
+	if v, ok := s.Get("z01"); !ok || !v {
+		t.Errorf("Get(z01) = %v, %v", v, ok)
+	}
+}
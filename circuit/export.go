@@ -0,0 +1,171 @@
+package circuit
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Outputs 返回不作为任何门输入的导线，即电路的输出，按名字排序。
+func (c *Circuit) Outputs() []string {
+	read := make([]bool, len(c.names))
+	for _, g := range c.order {
+		read[g.a], read[g.b] = true, true
+	}
+	var outputs []string
+	for i, name := range c.names {
+		if !read[i] && c.driver[i] >= 0 {
+			outputs = append(outputs, name)
+		}
+	}
+	slices.Sort(outputs)
+	return outputs
+}
+
+// Bit 返回导线所属的位：总线导线就是它的位号，门的输出是其输入中最高的位。
+// 对加法器来说，这正是该门所在的那一级全加器。不依赖任何总线的导线返回 -1。
+func (c *Circuit) Bit(wire string) int {
+	i, ok := c.index[wire]
+	if !ok {
+		return -1
+	}
+	return c.bits()[i]
+}
+
+// bits 按导线编号返回每根导线所属的位，见 Bit。
+func (c *Circuit) bits() []int {
+	bits := make([]int, len(c.names))
+	for i, name := range c.names {
+		bits[i] = -1
+		if _, bit, ok := busBit(name); ok && c.driver[i] < 0 {
+			bits[i] = bit
+		}
+	}
+	for _, g := range c.order {
+		bits[g.out] = max(bits[g.a], bits[g.b])
+		if _, bit, ok := busBit(c.names[g.out]); ok {
+			bits[g.out] = bit
+		}
+	}
+	return bits
+}
+
+// DOTOptions 控制 WriteDOT 的输出。
+type DOTOptions struct {
+	// Highlight 中的导线及驱动它们的门用红色标出。
+	Highlight []string
+	// GroupByBit 把门按所属的位（见 Circuit.Bit）分组，画成一个个子图。
+	GroupByBit bool
+}
+
+// WriteDOT 把电路写成 Graphviz DOT 有向图：每个门是一个方框节点，以它的
+// 输出导线命名；输入导线是椭圆节点；边从输入导线指向读取它的门。
+func WriteDOT(w io.Writer, c *Circuit, opts DOTOptions) error {
+	var b strings.Builder
+	highlight := make(map[string]bool)
+	for _, wire := range opts.Highlight {
+		highlight[wire] = true
+	}
+	attrs := func(wire string) string {
+		if highlight[wire] {
+			return ", color=red, fontcolor=red, penwidth=2"
+		}
+		return ""
+	}
+
+	b.WriteString("digraph circuit {\n\trankdir=LR;\n\tnode [fontname=\"monospace\"];\n")
+
+	// 节点声明：输入导线是椭圆，门是以输出导线命名的方框。分组时按位放进子图。
+	bits := c.bits()
+	groups := make(map[int][]string)
+	for i, name := range c.names {
+		decl := fmt.Sprintf("%q [shape=ellipse%s];", name, attrs(name))
+		if d := c.driver[i]; d >= 0 {
+			g := c.gates[d]
+			decl = fmt.Sprintf("%q [shape=box, label=\"%v\\n%s\"%s];", g.Out, g.Op, g.Out, attrs(g.Out))
+		}
+		bit := -1
+		if opts.GroupByBit {
+			bit = bits[i]
+		}
+		groups[bit] = append(groups[bit], decl)
+	}
+	for _, bit := range slices.Sorted(maps.Keys(groups)) {
+		indent := "\t"
+		if bit >= 0 {
+			fmt.Fprintf(&b, "\tsubgraph cluster_bit%02d {\n\t\tlabel=\"bit %d\";\n", bit, bit)
+			indent = "\t\t"
+		}
+		for _, decl := range groups[bit] {
+			b.WriteString(indent + decl + "\n")
+		}
+		if bit >= 0 {
+			b.WriteString("\t}\n")
+		}
+	}
+
+	for _, g := range c.gates {
+		for _, in := range []string{g.A, g.B} {
+			fmt.Fprintf(&b, "\t%q -> %q [label=%q%s];\n", in, g.Out, in, attrs(in))
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// verilogIdent 是不需要转义的 Verilog 标识符。
+var verilogIdent = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// verilogKeywords 是可能与三个字母的导线名冲突的 Verilog 关键字。
+var verilogKeywords = map[string]bool{
+	"and": true, "or": true, "xor": true, "not": true, "nor": true, "buf": true,
+	"end": true, "for": true, "if": true, "reg": true, "use": true, "tri": true,
+	"wor": true, "int": true, "bit": true, "ref": true, "let": true, "new": true,
+	"do": true, "var": true, "nand": true, "xnor": true, "wire": true, "case": true,
+}
+
+// verilogName 返回导线在 Verilog 中的写法，必要时写成转义标识符 "\name "。
+func verilogName(wire string) string {
+	if verilogIdent.MatchString(wire) && !verilogKeywords[wire] {
+		return wire
+	}
+	return `\` + wire + " "
+}
+
+// WriteVerilog 把电路写成结构化 Verilog 模块：输入、输出端口分别是
+// Circuit.Inputs 和 Circuit.Outputs，每个门是一条按拓扑顺序排列的 assign 语句。
+func WriteVerilog(w io.Writer, c *Circuit, module string) error {
+	var b strings.Builder
+	inputs, outputs := c.Inputs(), c.Outputs()
+	var ports []string
+	for _, name := range inputs {
+		ports = append(ports, "input wire "+verilogName(name))
+	}
+	for _, name := range outputs {
+		ports = append(ports, "output wire "+verilogName(name))
+	}
+	fmt.Fprintf(&b, "module %s (\n\t%s\n);\n", verilogName(module), strings.Join(ports, ",\n\t"))
+
+	isPort := make(map[string]bool)
+	for _, name := range slices.Concat(inputs, outputs) {
+		isPort[name] = true
+	}
+	for _, g := range c.gates {
+		if !isPort[g.Out] {
+			fmt.Fprintf(&b, "\twire %s;\n", verilogName(g.Out))
+		}
+	}
+	b.WriteString("\n")
+
+	ops := map[Op]string{And: "&", Or: "|", Xor: "^"}
+	for _, g := range c.gates {
+		fmt.Fprintf(&b, "\tassign %s = %s %s %s;\n", verilogName(g.Out), verilogName(g.A), ops[g.Op], verilogName(g.B))
+	}
+	b.WriteString("endmodule\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
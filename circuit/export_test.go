@@ -0,0 +1,90 @@
+package circuit
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestWriteDOT(t *testing.T) {
+	c, err := New(rippleAdder(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := WriteDOT(&b, c, DOTOptions{Highlight: []string{"car01"}, GroupByBit: true}); err != nil {
+		t.Fatal(err)
+	}
+	dot := b.String()
+	for _, want := range []string{
+		"digraph circuit {",
+		"subgraph cluster_bit02 {",
+		`"x01" [shape=ellipse];`,
+		`"car01" [shape=box, label="OR\ncar01", color=red`,
+		`"car01" -> "z02" [label="car01", color=red`,
+		`"x00" -> "z00" [label="x00"];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT output lacks %q:\n%s", want, dot)
+		}
+	}
+	if got, want := strings.Count(dot, " -> "), 2*len(c.Gates()); got != want {
+		t.Errorf("%d edges, want %d", got, want)
+	}
+
+	// 同一位的门应该在同一个子图中
+	cluster := dot[strings.Index(dot, "cluster_bit01"):]
+	cluster = cluster[:strings.Index(cluster, "}")]
+	for _, wire := range []string{"x01", "y01", "xor01", "and01", "thr01", "car01", "z01"} {
+		if !strings.Contains(cluster, `"`+wire+`"`) {
+			t.Errorf("bit 1 cluster lacks %s:\n%s", wire, cluster)
+		}
+	}
+}
+
+// assignPattern 匹配 WriteVerilog 输出的 assign 语句。
+var assignPattern = regexp.MustCompile(`assign (\\?\w+) *= (\\?\w+) *([&|^]) (\\?\w+) *;`)
+
+func TestWriteVerilog(t *testing.T) {
+	gates := rippleAdder(4)
+	// 把一根内部导线改名为 Verilog 关键字，检验转义
+	for i := range gates {
+		for _, w := range []*string{&gates[i].A, &gates[i].B, &gates[i].Out} {
+			if *w == "thr02" {
+				*w = "and"
+			}
+		}
+	}
+	c, err := New(gates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := WriteVerilog(&b, c, "adder4"); err != nil {
+		t.Fatal(err)
+	}
+	v := b.String()
+	for _, want := range []string{"module adder4 (", "input wire x03,", "output wire z04\n", `wire \and ;`, "endmodule\n"} {
+		if !strings.Contains(v, want) {
+			t.Errorf("Verilog output lacks %q:\n%s", want, v)
+		}
+	}
+
+	// 把 assign 语句读回成门，应当仍然是一个正确的加法器
+	ops := map[string]Op{"&": And, "|": Or, "^": Xor}
+	unescape := func(s string) string { return strings.TrimPrefix(s, `\`) }
+	var back []Gate
+	for _, m := range assignPattern.FindAllStringSubmatch(v, -1) {
+		back = append(back, Gate{A: unescape(m[2]), B: unescape(m[4]), Op: ops[m[3]], Out: unescape(m[1])})
+	}
+	if len(back) != len(gates) {
+		t.Fatalf("read back %d assigns, want %d:\n%s", len(back), len(gates), v)
+	}
+	rc, err := New(back)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckAdder(rc, 4, AdderTests(4)); err != nil {
+		t.Errorf("netlist read back from Verilog is not an adder: %v", err)
+	}
+}
@@ -0,0 +1,254 @@
+package circuit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// Swap 是一次输出交换：驱动这两根导线的门互换各自的输出导线。
+type Swap [2]string
+
+// Repair 是加法器修复的结果。
+type Repair struct {
+	Width int
+	// Swaps 是修复所需的交换，按找到的顺序排列。
+	Swaps []Swap
+	// Suspects 是结构规则标出的可疑导线，即搜索时优先尝试的候选。
+	Suspects []string
+	// Method 说明修复是怎样找到的："already correct"、"suspect pairing"
+	// 或 "bit-by-bit search"。
+	Method string
+	// Tests 是验证用的测试加法次数，Passed 是修复后的电路算对的次数。
+	Tests, Passed int
+	// Circuit 是修复后的电路。
+	Circuit *Circuit
+}
+
+// ErrNoRepair 表示在允许的交换次数内找不到能让电路成为加法器的修复。
+var ErrNoRepair = errors.New("circuit: no repair found")
+
+// RepairAdder 在最多 maxSwaps 次输出交换内把 gates 修复为 width 位加法器
+// （z = x + y），每个候选修复都要用 AdderTests 的全部用例模拟验证。
+//
+// 先尝试把 Suspects 标出的可疑导线两两配对；不成功时逐位搜索：找到当前最低
+// 的错误位，在影响该位的门的输出与其他门的输出之间尝试交换，保留使最低错误位
+// 上升的交换并继续，失败则回溯。
+func RepairAdder(gates []Gate, width, maxSwaps int) (*Repair, error) {
+	tests := AdderTests(width)
+	r := &Repair{Width: width, Suspects: Suspects(gates, width), Tests: len(tests)}
+
+	c, err := New(gates)
+	if err != nil {
+		return nil, err
+	}
+	first := CheckAdder(c, width, tests)
+	var adderErr *AdderError
+	switch {
+	case first == nil:
+		r.Circuit, r.Method = c, "already correct"
+		r.Passed = PassingTests(c, width, tests)
+		return r, nil
+	case !errors.As(first, &adderErr):
+		return nil, first
+	}
+
+	if len(r.Suspects)%2 == 0 && len(r.Suspects)/2 <= maxSwaps {
+		for swaps := range pairings(r.Suspects) {
+			if fixed, ok := applySwaps(gates, swaps, width, tests); ok {
+				r.Swaps, r.Circuit, r.Method = swaps, fixed, "suspect pairing"
+				r.Passed = PassingTests(fixed, width, tests)
+				return r, nil
+			}
+		}
+	}
+
+	s := &searcher{width: width, tests: tests, suspects: r.Suspects}
+	swaps, fixed, ok := s.search(gates, c, adderErr.Bit, maxSwaps)
+	if !ok {
+		return nil, fmt.Errorf("%w within %d swaps: %w", ErrNoRepair, maxSwaps, first)
+	}
+	r.Swaps, r.Circuit, r.Method = swaps, fixed, "bit-by-bit search"
+	r.Passed = PassingTests(fixed, width, tests)
+	return r, nil
+}
+
+// Wires 返回所有被交换的导线，排序后的列表就是第 24 天第二部分的答案。
+func (r *Repair) Wires() []string {
+	var wires []string
+	for _, s := range r.Swaps {
+		wires = append(wires, s[0], s[1])
+	}
+	slices.Sort(wires)
+	return wires
+}
+
+// WriteReport 写出修复报告：交换了哪些导线、各自原来由哪个门驱动，
+// 以及修复后的电路通过了多少次测试加法。
+func (r *Repair) WriteReport(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d-bit adder: %d swaps found by %s\n", r.Width, len(r.Swaps), r.Method)
+	for _, s := range r.Swaps {
+		g0, _ := r.Circuit.Driver(s[0])
+		g1, _ := r.Circuit.Driver(s[1])
+		fmt.Fprintf(&b, "  %s <-> %s  now %v; %v\n", s[0], s[1], g0, g1)
+	}
+	fmt.Fprintf(&b, "suspects: %s\n", strings.Join(r.Suspects, ","))
+	fmt.Fprintf(&b, "verified: %d of %d test additions give x + y\n", r.Passed, r.Tests)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// SwapOutputs 返回交换 a、b 两根导线的驱动门之后的门列表，不修改 gates。
+func SwapOutputs(gates []Gate, a, b string) []Gate {
+	swapped := slices.Clone(gates)
+	for i := range swapped {
+		switch swapped[i].Out {
+		case a:
+			swapped[i].Out = b
+		case b:
+			swapped[i].Out = a
+		}
+	}
+	return swapped
+}
+
+// applySwaps 依次执行 swaps，修复后的电路通过全部测试时返回它。
+func applySwaps(gates []Gate, swaps []Swap, width int, tests [][2]*big.Int) (*Circuit, bool) {
+	for _, s := range swaps {
+		gates = SwapOutputs(gates, s[0], s[1])
+	}
+	c, err := New(gates)
+	if err != nil {
+		return nil, false
+	}
+	return c, CheckAdder(c, width, tests) == nil
+}
+
+// pairings 产出把 wires 两两配对的所有方式。
+func pairings(wires []string) iter.Seq[[]Swap] {
+	return func(yield func([]Swap) bool) {
+		var rec func(rest []string, acc []Swap) bool
+		rec = func(rest []string, acc []Swap) bool {
+			if len(rest) == 0 {
+				return yield(slices.Clone(acc))
+			}
+			for i := 1; i < len(rest); i++ {
+				others := slices.Concat(rest[1:i], rest[i+1:])
+				if !rec(others, append(acc, Swap{rest[0], rest[i]})) {
+					return false
+				}
+			}
+			return true
+		}
+		rec(wires, nil)
+	}
+}
+
+// searcher 实现 RepairAdder 的逐位搜索。
+type searcher struct {
+	width    int
+	tests    [][2]*big.Int
+	suspects []string
+}
+
+// search 在电路 c（由 gates 构成，最低错误位为 bad）上最多再做 left 次交换。
+func (s *searcher) search(gates []Gate, c *Circuit, bad, left int) ([]Swap, *Circuit, bool) {
+	if left == 0 {
+		return nil, nil, false
+	}
+	for _, sw := range s.candidates(gates, c, bad) {
+		swapped := SwapOutputs(gates, sw[0], sw[1])
+		next, err := New(swapped)
+		if err != nil {
+			continue // 交换后出现环
+		}
+		var adderErr *AdderError
+		switch err := CheckAdder(next, s.width, s.tests); {
+		case err == nil:
+			return []Swap{sw}, next, true
+		case !errors.As(err, &adderErr) || adderErr.Bit <= bad:
+			continue
+		}
+		if rest, fixed, ok := s.search(swapped, next, adderErr.Bit, left-1); ok {
+			return append([]Swap{sw}, rest...), fixed, true
+		}
+	}
+	return nil, nil, false
+}
+
+// candidates 返回修复第 bad 位时要尝试的交换。一侧是只影响 z_bad、z_bad+1
+// 而不影响更低位的门的输出，另一侧是任意门的输出；可疑导线排在前面。
+func (s *searcher) candidates(gates []Gate, c *Circuit, bad int) []Swap {
+	z := c.buses["z"]
+	lower := make(map[string]bool)
+	for bit := range min(bad, len(z)) {
+		c.cone(z[bit], lower)
+	}
+	local := make(map[string]bool)
+	for bit := bad; bit < min(bad+2, len(z)); bit++ {
+		c.cone(z[bit], local)
+	}
+	suspect := make(map[string]bool)
+	for _, w := range s.suspects {
+		suspect[w] = true
+	}
+
+	var near, all []string
+	for _, g := range gates {
+		all = append(all, g.Out)
+		if local[g.Out] && !lower[g.Out] {
+			near = append(near, g.Out)
+		}
+	}
+	rank := func(w string) int {
+		if suspect[w] {
+			return 0
+		}
+		return 1
+	}
+	byRank := func(a, b string) int { return rank(a) - rank(b) }
+	slices.SortStableFunc(near, byRank)
+	slices.SortStableFunc(all, byRank)
+
+	seen := make(map[Swap]bool)
+	var swaps []Swap
+	for _, a := range near {
+		for _, b := range all {
+			key := Swap{min(a, b), max(a, b)}
+			if a == b || seen[key] {
+				continue
+			}
+			seen[key] = true
+			swaps = append(swaps, Swap{a, b})
+		}
+	}
+	// 两侧都可疑的交换最先尝试。
+	slices.SortStableFunc(swaps, func(x, y Swap) int {
+		return rank(x[0]) + rank(x[1]) - rank(y[0]) - rank(y[1])
+	})
+	return swaps
+}
+
+// cone 把驱动编号为 wire 的导线的所有门（扇入锥）的输出加入 set。
+// wire 为 -1（总线上缺失的位）时什么也不做。
+func (c *Circuit) cone(wire int, set map[string]bool) {
+	if wire < 0 {
+		return
+	}
+	stack := []int{wire}
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		d := c.driver[i]
+		if d < 0 || set[c.names[i]] {
+			continue
+		}
+		set[c.names[i]] = true
+		stack = append(stack, c.order[d].a, c.order[d].b)
+	}
+}
@@ -0,0 +1,156 @@
+package circuit
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCheckAdder(t *testing.T) {
+	const width = 8
+	tests := AdderTests(width)
+	c, err := New(rippleAdder(width))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckAdder(c, width, tests); err != nil {
+		t.Fatalf("CheckAdder() on a correct adder = %v", err)
+	}
+	if n := PassingTests(c, width, tests); n != len(tests) {
+		t.Errorf("PassingTests() on a correct adder = %d, want %d", n, len(tests))
+	}
+
+	// 第 5 位的进位 AND 门误接成 OR：只有进位传入且本位 x、y 都为 0 时才出错
+	gates := rippleAdder(width)
+	for i, g := range gates {
+		if g.Out == "thr05" {
+			gates[i].Op = Or
+		}
+	}
+	c, _ = New(gates)
+	var adderErr *AdderError
+	if err := CheckAdder(c, width, tests); !errors.As(err, &adderErr) || adderErr.Bit != 6 {
+		t.Fatalf("CheckAdder() = %v, want a failure at bit 6", err)
+	}
+	if want := new(big.Int).Add(adderErr.X, adderErr.Y); want.Cmp(adderErr.Want) != 0 || adderErr.Got.Cmp(want) == 0 {
+		t.Errorf("inconsistent failure %v", adderErr)
+	}
+	if n := PassingTests(c, width, tests); n == 0 || n >= len(tests) {
+		t.Errorf("PassingTests() = %d, want between 1 and %d", n, len(tests)-1)
+	}
+
+	c, _ = New(append(rippleAdder(width), Gate{"q", "x00", And, "r"}))
+	if err := CheckAdder(c, width, tests); err == nil || errors.As(err, &adderErr) {
+		t.Errorf("CheckAdder() with an extra input = %v, want a shape error", err)
+	}
+}
+
+func TestRepairAdder(t *testing.T) {
+	tests := []struct {
+		name       string
+		width      int
+		swaps      []Swap
+		wantMethod string
+	}{
+		{
+			name:       "puzzle-like",
+			width:      45,
+			swaps:      []Swap{{"z07", "and07"}, {"z20", "car20"}, {"xor23", "and23"}, {"z38", "thr38"}},
+			wantMethod: "suspect pairing",
+		},
+		{
+			// 两个半加和互换，结构规则看不出来，只能逐位搜索
+			name:       "swap invisible to the rules",
+			width:      12,
+			swaps:      []Swap{{"xor05", "xor07"}},
+			wantMethod: "bit-by-bit search",
+		},
+		{
+			name:       "mixed",
+			width:      16,
+			swaps:      []Swap{{"xor03", "xor04"}, {"z10", "car10"}},
+			wantMethod: "bit-by-bit search",
+		},
+		{name: "already correct", width: 6, wantMethod: "already correct"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gates := rippleAdder(tt.width)
+			var want []string
+			for _, s := range tt.swaps {
+				gates = SwapOutputs(gates, s[0], s[1])
+				want = append(want, s[0], s[1])
+			}
+			slices.Sort(want)
+
+			r, err := RepairAdder(gates, tt.width, 4)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := r.Wires(); !slices.Equal(got, want) {
+				t.Errorf("Wires() = %v, want %v", got, want)
+			}
+			if r.Method != tt.wantMethod {
+				t.Errorf("Method = %q, want %q", r.Method, tt.wantMethod)
+			}
+			if err := CheckAdder(r.Circuit, tt.width, AdderTests(tt.width)); err != nil {
+				t.Errorf("repaired circuit fails: %v", err)
+			}
+
+			var report strings.Builder
+			if err := r.WriteReport(&report); err != nil {
+				t.Fatal(err)
+			}
+			n := len(AdderTests(tt.width))
+			for _, line := range []string{
+				fmt.Sprintf("%d swaps found by %s\n", len(tt.swaps), tt.wantMethod),
+				fmt.Sprintf("verified: %d of %d test additions give x + y\n", n, n),
+			} {
+				if !strings.Contains(report.String(), line) {
+					t.Errorf("report %q does not contain %q", report.String(), line)
+				}
+			}
+		})
+	}
+}
+
+func TestRepairAdderFails(t *testing.T) {
+	gates := rippleAdder(8)
+	for i, g := range gates {
+		if g.Out == "z04" {
+			gates[i].Op = And // 门的类型错了，交换输出修不好
+		}
+	}
+	if _, err := RepairAdder(gates, 8, 2); !errors.Is(err, ErrNoRepair) {
+		t.Errorf("RepairAdder() = %v, want ErrNoRepair", err)
+	}
+}
+
+func TestSuspects(t *testing.T) {
+	gates := rippleAdder(45)
+	if got := Suspects(gates, 45); len(got) != 0 {
+		t.Errorf("Suspects() of a correct adder = %v", got)
+	}
+	gates = SwapOutputs(gates, "z07", "and07")
+	gates = SwapOutputs(gates, "z45", "xor44") // 这一对只有 z45 违反规则：xor44 现在由 OR 门驱动，但仍进入 XOR 门
+	if got, want := Suspects(gates, 45), []string{"and07", "z07", "z45"}; !slices.Equal(got, want) {
+		t.Errorf("Suspects() = %v, want %v", got, want)
+	}
+}
+
+func TestPairings(t *testing.T) {
+	n := 0
+	for p := range pairings([]string{"a", "b", "c", "d", "e", "f"}) {
+		if len(p) != 3 {
+			t.Fatalf("pairing %v", p)
+		}
+		n++
+	}
+	if n != 15 {
+		t.Errorf("%d pairings of 6 wires, want 15", n)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"adventofcode/bench"
+	"adventofcode/registry"
+	"adventofcode/result"
+)
+
+// benchCommand 实现 "aoc bench [day [part]]"：把每个求解函数运行若干次，
+// 统计耗时、分配和堆峰值，可选地写出报告并与基线比较。
+// 有求解失败或性能回退超过阈值时退出码为 1。
+func benchCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	runs := fs.Int("n", 5, "number of runs per solver")
+	root := fs.String("root", ".", "repository root used to locate default input files")
+	jsonPath := fs.String("json", "", "write the report as JSON to this file")
+	csvPath := fs.String("csv", "", "write the report as CSV to this file")
+	baselinePath := fs.String("baseline", "", "compare against this JSON report")
+	threshold := fs.Float64("threshold", 0.2, "relative slowdown (or allocation growth) counted as a regression")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: aoc bench [day|all] [part] [-n runs] [--root dir] [--json path] [--csv path] [--baseline path] [--threshold 0.2]")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if *runs < 1 {
+		fmt.Fprintln(stderr, "aoc bench: -n must be positive")
+		return 2
+	}
+	entries, err := selectEntries(registry.Calendar(), positional)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc bench: %v\n", err)
+		return exitCodeFor(err)
+	}
+
+	// 先读基线，避免跑完很久之后才发现路径写错了。
+	var baseline *bench.Report
+	if *baselinePath != "" {
+		if baseline, err = bench.Load(*baselinePath); err != nil {
+			fmt.Fprintf(stderr, "aoc bench: %v\n", err)
+			return 1
+		}
+	}
+
+	report := bench.NewReport()
+	failed := 0
+	for _, e := range entries {
+		prefix := fmt.Sprintf("day %2d part %d:", e.Day, e.Part)
+		data, err := readInput(*root, "", e)
+		if errors.Is(err, errNoInput) {
+			fmt.Fprintf(stdout, "%s skipped, no input file\n", prefix)
+			continue
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "%s %v\n", prefix, err)
+			failed++
+			continue
+		}
+
+		res, err := bench.Measure(e.Day, e.Part, safeSolver(e.Solve), string(data), *runs)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s %v\n", prefix, err)
+			failed++
+			continue
+		}
+		report.Results = append(report.Results, res)
+		fmt.Fprintf(stdout, "%s median %10s  min %10s  %9d allocs/op  %9s/op  peak heap %9s\n",
+			prefix, roundDuration(res.Median()), roundDuration(time.Duration(res.MinNS)),
+			res.AllocsPerRun, formatBytes(res.BytesPerRun), formatBytes(res.PeakHeapBytes))
+	}
+
+	for _, out := range []struct {
+		path  string
+		write func() error
+	}{
+		{*jsonPath, func() error { return report.Save(*jsonPath) }},
+		{*csvPath, func() error { return report.Save(*csvPath) }},
+	} {
+		if out.path == "" {
+			continue
+		}
+		if err := out.write(); err != nil {
+			fmt.Fprintf(stderr, "aoc bench: %v\n", err)
+			failed++
+		}
+	}
+
+	if baseline != nil {
+		regs := bench.Compare(baseline, report, *threshold)
+		for _, r := range regs {
+			fmt.Fprintf(stdout, "REGRESSION %s\n", r)
+		}
+		fmt.Fprintf(stdout, "%d regressions against %s (threshold %.0f%%)\n", len(regs), *baselinePath, *threshold*100)
+		if len(regs) > 0 {
+			return 1
+		}
+	}
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// safeSolver 包装求解函数，把 panic 转换为错误。
+func safeSolver(solve registry.Solver) registry.Solver {
+	return func(input string) (string, error) {
+		r := result.Solve(0, 0, solve, input)
+		return r.Answer, r.Err
+	}
+}
+
+// roundDuration 按量级保留三位左右的有效数字，便于对齐阅读。
+func roundDuration(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond)
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond)
+	default:
+		return d.Round(100 * time.Nanosecond)
+	}
+}
+
+// formatBytes 以二进制单位格式化字节数。
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"adventofcode/circuit"
+	"adventofcode/day24"
+	"adventofcode/registry"
+)
+
+// circuitCommand 实现 "aoc circuit export [path|-]"：把第 24 天的电路导出为
+// Graphviz DOT 或结构化 Verilog，便于画图或用 HDL 仿真器离线检查。
+func circuitCommand(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] != "export" {
+		fmt.Fprintln(stderr, "usage: aoc circuit export [path|-] [--format dot|verilog] [--highlight] [--group] [-o file]")
+		return 2
+	}
+
+	fs := flag.NewFlagSet("circuit export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	root := fs.String("root", ".", "repository root used to locate the default input file")
+	format := fs.String("format", "dot", "output format: dot or verilog")
+	highlight := fs.Bool("highlight", false, "colour the wires flagged by the adder structure rules (dot only)")
+	group := fs.Bool("group", false, "group gates by the bit position they belong to (dot only)")
+	module := fs.String("module", "adder", "Verilog module name")
+	outPath := fs.String("o", "", "write to this file instead of stdout")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: aoc circuit export [path|-] [--format dot|verilog] [--highlight] [--group] [-o file]")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		return 2
+	}
+	if len(positional) > 1 {
+		fs.Usage()
+		return 2
+	}
+	if *format != "dot" && *format != "verilog" {
+		fmt.Fprintf(stderr, "aoc circuit export: invalid format %q: want dot or verilog\n", *format)
+		return 2
+	}
+	inputPath := ""
+	if len(positional) == 1 {
+		inputPath = positional[0]
+	}
+
+	data, err := readInput(*root, inputPath, registry.Entry{Day: 24, Part: 1})
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc circuit export: %v\n", err)
+		return 1
+	}
+	parsed, err := day24.Parse(strings.NewReader(string(data)))
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc circuit export: %v\n", err)
+		return 1
+	}
+	c, err := circuit.New(parsed.Gates)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc circuit export: %v\n", err)
+		return 1
+	}
+
+	w := stdout
+	var f *os.File
+	if *outPath != "" {
+		if f, err = os.Create(*outPath); err != nil {
+			fmt.Fprintf(stderr, "aoc circuit export: %v\n", err)
+			return 1
+		}
+		w = f
+	}
+
+	if *format == "verilog" {
+		err = circuit.WriteVerilog(w, c, *module)
+	} else {
+		opts := circuit.DOTOptions{GroupByBit: *group}
+		if *highlight {
+			opts.Highlight = circuit.Suspects(parsed.Gates, c.Width("x"))
+		}
+		err = circuit.WriteDOT(w, c, opts)
+	}
+	if f != nil {
+		// 关闭时才报告的写入错误也要算失败，否则会留下不完整的文件。
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc circuit export: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"adventofcode/puzzle"
+)
+
+// fetchCommand 实现 "aoc fetch <day|all>"：下载谜题输入并保存到缓存。
+// 已缓存的输入不会重复下载，除非指定 --force。
+func fetchCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	force := fs.Bool("force", false, "download again even if the input is already cached")
+	year := fs.Int("year", puzzle.DefaultYear, "puzzle year")
+	baseURL := fs.String("url", puzzle.DefaultBaseURL, "puzzle site base URL")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: aoc fetch <day|all> [--force] [--year n] [--url base]")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return 2
+	}
+	var days []int
+	if positional[0] == "all" {
+		for day := 1; day <= 25; day++ {
+			days = append(days, day)
+		}
+	} else {
+		day, err := parseDay(positional[0])
+		if err != nil {
+			fmt.Fprintf(stderr, "aoc fetch: %v\n", err)
+			return 2
+		}
+		days = []int{day}
+	}
+
+	cache, err := puzzle.DefaultCache(*year)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc fetch: %v\n", err)
+		return 1
+	}
+	var client *puzzle.Client // 全部命中缓存时不需要会话令牌
+
+	failed := 0
+	for _, day := range days {
+		if _, ok, err := cache.Load(day); err != nil {
+			fmt.Fprintf(stderr, "day %d: %v\n", day, err)
+			failed++
+			continue
+		} else if ok && !*force {
+			fmt.Fprintf(stdout, "day %2d: cached %s\n", day, cache.Path(day))
+			continue
+		}
+
+		if client == nil {
+			session, err := puzzle.LoadSession()
+			if err != nil {
+				fmt.Fprintf(stderr, "aoc fetch: %v\n", err)
+				return 1
+			}
+			client = puzzle.New(session)
+			client.BaseURL = *baseURL
+			client.Year = *year
+		}
+		data, err := client.Input(context.Background(), day)
+		if err == nil {
+			err = cache.Store(day, data)
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "day %d: %v\n", day, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(stdout, "day %2d: fetched %s (%d bytes)\n", day, cache.Path(day), len(data))
+	}
+
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+)
+
+// parseInterspersed 允许位置参数和选项混合出现（例如 "16 2 --input x"），
+// 标准库 flag 包遇到第一个位置参数就会停止解析。
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// parseDay 解析 1–25 之间的日期编号。
+func parseDay(s string) (int, error) {
+	day, err := strconv.Atoi(s)
+	if err != nil || day < 1 || day > 25 {
+		return 0, fmt.Errorf("invalid day %q: want a number between 1 and 25", s)
+	}
+	return day, nil
+}
+
+// parsePart 解析部分编号 1 或 2。
+func parsePart(s string) (int, error) {
+	part, err := strconv.Atoi(s)
+	if err != nil || part < 1 || part > 2 {
+		return 0, fmt.Errorf("invalid part %q: want 1 or 2", s)
+	}
+	return part, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"adventofcode/puzzle"
+)
+
+// errNoInput 表示某一天某一部分找不到默认输入文件。
+var errNoInput = errors.New("no input file")
+
+// defaultInputPath 返回某一天某一部分的默认输入文件路径。
+// 输入文件按惯例放在 dayNN/partN/input；两部分共用同一份输入，
+// 所以当前部分的目录里没有时，退而使用另一部分目录里的文件。
+// 每个目录里也接受 gzip 压缩的 input.gz。
+func defaultInputPath(root string, day, part int) (string, error) {
+	var candidates []string
+	for _, p := range []int{part, 3 - part} {
+		dir := filepath.Join(root, fmt.Sprintf("day%02d", day), fmt.Sprintf("part%d", p))
+		candidates = append(candidates, filepath.Join(dir, "input"), filepath.Join(dir, "input.gz"))
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("%w for day %d part %d (looked for %s)", errNoInput, day, part, candidates[0])
+}
+
+// cachedInput 返回 "aoc fetch" 缓存中某一天的输入；缓存不可用时返回 false。
+func cachedInput(day int) ([]byte, bool) {
+	cache, err := puzzle.DefaultCache(puzzle.DefaultYear)
+	if err != nil {
+		return nil, false
+	}
+	data, ok, err := cache.Load(day)
+	return data, ok && err == nil
+}
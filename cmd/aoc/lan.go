@@ -0,0 +1,254 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"adventofcode/day23"
+	"adventofcode/netgraph"
+	"adventofcode/registry"
+	"adventofcode/result"
+)
+
+const lanUsage = `usage: aoc lan <command> [args] [path|-] [--format text|json]
+
+commands:
+  components          connected components, largest first
+  kcore               core number of every computer and the size of each k-core
+  degrees             degree distribution
+  path <from> <to>    a shortest hop path between two computers
+  articulation        computers whose removal disconnects the network
+  bridges             connections whose removal disconnects the network
+
+path defaults to the day 23 puzzle input; "-" reads stdin.
+`
+
+// lanReport 是一个 lan 子命令的结果：JSON 格式直接编码，文本格式调用 writeText。
+// 报告中的切片都要初始化，没有结果时编码成 [] 而不是 null。
+type lanReport interface {
+	writeText(w io.Writer)
+}
+
+// lanCommands 是各个子命令：needs 是子命令自身需要的位置参数个数（不含输入路径）。
+var lanCommands = map[string]struct {
+	needs int
+	run   func(g *netgraph.Graph, args []string) (lanReport, error)
+}{
+	"components":   {0, lanComponents},
+	"kcore":        {0, lanKCore},
+	"degrees":      {0, lanDegrees},
+	"path":         {2, lanPath},
+	"articulation": {0, lanArticulation},
+	"bridges":      {0, lanBridges},
+}
+
+// lanCommand 实现 "aoc lan"：对第 23 天的局域网做连通性等分析。
+// 输入只解析一次，建成 netgraph.Graph 后交给各个子命令。
+func lanCommand(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, lanUsage)
+		return 2
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		fmt.Fprint(stdout, lanUsage)
+		return 0
+	}
+	cmd, ok := lanCommands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "aoc lan: unknown command %q\n\n%s", args[0], lanUsage)
+		return 2
+	}
+	name := args[0]
+
+	fs := flag.NewFlagSet("lan "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	root := fs.String("root", ".", "repository root used to locate the default input file")
+	format := result.Text
+	fs.Var(&format, "format", "output format: text or json")
+	fs.Usage = func() { fmt.Fprint(stderr, lanUsage) }
+
+	positional, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		return 2
+	}
+	if len(positional) < cmd.needs || len(positional) > cmd.needs+1 {
+		fs.Usage()
+		return 2
+	}
+	inputPath := ""
+	if len(positional) > cmd.needs {
+		inputPath = positional[cmd.needs]
+	}
+
+	data, err := readInput(*root, inputPath, registry.Entry{Day: 23, Part: 1})
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc lan %s: %v\n", name, err)
+		return 1
+	}
+	conns, err := day23.Parse(strings.NewReader(string(data)))
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc lan %s: %v\n", name, err)
+		return 1
+	}
+
+	report, err := cmd.run(day23.Network(conns), positional[:cmd.needs])
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc lan %s: %v\n", name, err)
+		return 1
+	}
+	if format == result.JSON {
+		data, err := json.Marshal(report)
+		if err != nil {
+			fmt.Fprintf(stderr, "aoc lan %s: %v\n", name, err)
+			return 1
+		}
+		fmt.Fprintf(stdout, "%s\n", data)
+		return 0
+	}
+	report.writeText(stdout)
+	return 0
+}
+
+type componentsReport struct {
+	Components [][]string `json:"components"`
+}
+
+func lanComponents(g *netgraph.Graph, _ []string) (lanReport, error) {
+	r := componentsReport{Components: [][]string{}}
+	for _, c := range g.Components() {
+		r.Components = append(r.Components, g.Names(c))
+	}
+	return r, nil
+}
+
+func (r componentsReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "%d components\n", len(r.Components))
+	for _, c := range r.Components {
+		fmt.Fprintf(w, "%4d: %s\n", len(c), strings.Join(c, ","))
+	}
+}
+
+type kcoreReport struct {
+	Degeneracy int `json:"degeneracy"`
+	// Sizes[k] 是 k 核中的计算机数。
+	Sizes []int          `json:"sizes"`
+	Core  map[string]int `json:"core"`
+	// Top 是最内层核（k = Degeneracy）中的计算机。
+	Top []string `json:"top"`
+}
+
+func lanKCore(g *netgraph.Graph, _ []string) (lanReport, error) {
+	cores := g.CoreNumbers()
+	r := kcoreReport{Core: make(map[string]int), Top: []string{}}
+	for i, k := range cores {
+		r.Core[g.Name(i)] = k
+		r.Degeneracy = max(r.Degeneracy, k)
+	}
+	r.Sizes = make([]int, r.Degeneracy+1)
+	for i, k := range cores {
+		for j := range k + 1 {
+			r.Sizes[j]++
+		}
+		if k == r.Degeneracy {
+			r.Top = append(r.Top, g.Name(i))
+		}
+	}
+	return r, nil
+}
+
+func (r kcoreReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "degeneracy %d\n", r.Degeneracy)
+	for k := len(r.Sizes) - 1; k >= 0; k-- {
+		fmt.Fprintf(w, "%3d-core: %d computers\n", k, r.Sizes[k])
+	}
+	fmt.Fprintf(w, "innermost core: %s\n", strings.Join(r.Top, ","))
+}
+
+type degreeCount struct {
+	Degree int `json:"degree"`
+	Count  int `json:"count"`
+}
+
+type degreesReport struct {
+	Histogram []degreeCount `json:"histogram"`
+}
+
+func lanDegrees(g *netgraph.Graph, _ []string) (lanReport, error) {
+	r := degreesReport{Histogram: []degreeCount{}}
+	for d, n := range g.DegreeHistogram() {
+		if n > 0 {
+			r.Histogram = append(r.Histogram, degreeCount{d, n})
+		}
+	}
+	return r, nil
+}
+
+func (r degreesReport) writeText(w io.Writer) {
+	for _, h := range r.Histogram {
+		fmt.Fprintf(w, "degree %3d: %d computers\n", h.Degree, h.Count)
+	}
+}
+
+type pathReport struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Hops int      `json:"hops"`
+	Path []string `json:"path"`
+}
+
+func lanPath(g *netgraph.Graph, args []string) (lanReport, error) {
+	var ends [2]int
+	for i, name := range args {
+		id, ok := g.Index(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown computer %q", name)
+		}
+		ends[i] = id
+	}
+	path := g.ShortestPath(ends[0], ends[1])
+	if path == nil {
+		return nil, fmt.Errorf("%s and %s are not connected", args[0], args[1])
+	}
+	return pathReport{From: args[0], To: args[1], Hops: len(path) - 1, Path: g.Names(path)}, nil
+}
+
+func (r pathReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "%s (%d hops)\n", strings.Join(r.Path, " -> "), r.Hops)
+}
+
+type articulationReport struct {
+	Points []string `json:"articulation_points"`
+}
+
+func lanArticulation(g *netgraph.Graph, _ []string) (lanReport, error) {
+	return articulationReport{Points: g.Names(g.ArticulationPoints())}, nil
+}
+
+func (r articulationReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "%d articulation points\n", len(r.Points))
+	for _, p := range r.Points {
+		fmt.Fprintln(w, p)
+	}
+}
+
+type bridgesReport struct {
+	Bridges [][2]string `json:"bridges"`
+}
+
+func lanBridges(g *netgraph.Graph, _ []string) (lanReport, error) {
+	r := bridgesReport{Bridges: [][2]string{}}
+	for _, b := range g.Bridges() {
+		r.Bridges = append(r.Bridges, [2]string{g.Name(b[0]), g.Name(b[1])})
+	}
+	return r, nil
+}
+
+func (r bridgesReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "%d bridges\n", len(r.Bridges))
+	for _, b := range r.Bridges {
+		fmt.Fprintf(w, "%s-%s\n", b[0], b[1])
+	}
+}
@@ -0,0 +1,76 @@
+// Command aoc 是整个日历的统一入口，按 (day, part) 从注册表中调度求解函数。
+//
+// 用法:
+//
+//	aoc run <day|all> [part] [-] [--input path] [--root dir] [--format text|json]
+//
+//	aoc bench [day|all] [part] [-n runs] [--json path] [--csv path] [--baseline path] [--threshold 0.2]
+//	aoc fetch <day|all> [--force] [--year n] [--url base]
+//	aoc submit <day> <part> <answer> [--year n] [--url base] [--log path]
+//	aoc verify [day|all] [part] [--root dir] [--answers path] [--record] [--format text|json]
+//	aoc circuit export [path|-] [--format dot|verilog] [--highlight] [--group] [-o file]
+//	aoc lan <components|kcore|degrees|path a b|articulation|bridges> [path|-] [--format text|json]
+//	aoc vm <disasm|asm|run|trace|debug> [path|-] [--a n] [--compiled] [--break ip] [--when cond]
+//
+// 谜题输入可以是 gzip 压缩的；"-" 表示从标准输入读取，例如
+// "cat input | aoc run 5 2 -"。
+//
+// "--format json" 让 run 和 verify 每个结果输出一行 JSON 对象（day、part、
+// answer、duration_ns，以及可选的 diagnostics 和 error），供脚本解析。
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+const usage = `usage: aoc <command> [arguments]
+
+commands:
+  run <day|all> [part]     solve one day (or every registered day) and print the answers
+  bench [day|all] [part]   time solvers and compare against a baseline report
+  fetch <day|all>          download puzzle input into the local cache
+  submit <day> <part> <a>  submit an answer, refusing ones already known to be wrong
+  verify [day|all] [part]  check the answers against answers.txt; exits 1 on any failure
+  circuit export [path]    export the day 24 circuit as Graphviz DOT or Verilog
+  lan <command> [path]     analyse the day 23 LAN graph (components, k-cores, paths, cuts)
+  vm <command> [path]      disassemble, assemble, run, trace or debug a day 17 program
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run 根据子命令分发，返回进程退出码。
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	switch args[0] {
+	case "run":
+		return runCommand(args[1:], stdout, stderr)
+	case "bench":
+		return benchCommand(args[1:], stdout, stderr)
+	case "fetch":
+		return fetchCommand(args[1:], stdout, stderr)
+	case "submit":
+		return submitCommand(args[1:], stdout, stderr)
+	case "verify":
+		return verifyCommand(args[1:], stdout, stderr)
+	case "circuit":
+		return circuitCommand(args[1:], stdout, stderr)
+	case "lan":
+		return lanCommand(args[1:], stdout, stderr)
+	case "vm":
+		return vmCommand(args[1:], stdout, stderr)
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "aoc: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+}
@@ -0,0 +1,553 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"adventofcode/answers"
+	"adventofcode/bench"
+)
+
+func TestParseInterspersed(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	input := fs.String("input", "", "")
+
+	positional, err := parseInterspersed(fs, []string{"16", "--input", "x.txt", "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"16", "2"}; !reflect.DeepEqual(positional, want) {
+		t.Errorf("positional = %v, want %v", positional, want)
+	}
+	if *input != "x.txt" {
+		t.Errorf("input = %q, want %q", *input, "x.txt")
+	}
+}
+
+func TestDefaultInputPathFallsBackToOtherPart(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "day02", "part2")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "input"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := defaultInputPath(root, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "input"); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if _, err := defaultInputPath(root, 3, 1); err == nil {
+		t.Error("expected error for a day without input")
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input")
+	if err := os.WriteFile(path, []byte("3   4\n4   3\n2   5\n1   3\n3   9\n3   3\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantOut  []string
+	}{
+		{
+			name:     "both parts",
+			args:     []string{"run", "1", "--input", path},
+			wantCode: 0,
+			wantOut:  []string{"day  1 part 1: 11 ", "day  1 part 2: 31 "},
+		},
+		{
+			name:     "single part",
+			args:     []string{"run", "1", "2", "--input", path},
+			wantCode: 0,
+			wantOut:  []string{"day  1 part 2: 31 "},
+		},
+		{
+			name:     "not implemented",
+			args:     []string{"run", "2", "1", "--input", path},
+			wantCode: 1,
+		},
+		{
+			name:     "bad day",
+			args:     []string{"run", "26"},
+			wantCode: 2,
+		},
+		{
+			name:     "unknown command",
+			args:     []string{"frobnicate"},
+			wantCode: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stderr: %s)", code, tt.wantCode, stderr.String())
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("stdout %q does not contain %q", stdout.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestRunCommandStdinAndGzip(t *testing.T) {
+	const input = "3   4\n4   3\n2   5\n1   3\n3   9\n3   3\n"
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(input))
+	zw.Close()
+	gz := filepath.Join(t.TempDir(), "input.gz")
+	if err := os.WriteFile(gz, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		stdin    string
+		wantCode int
+		wantOut  string
+	}{
+		{"stdin", []string{"run", "1", "2", "-"}, input, 0, "day  1 part 2: 31 "},
+		{"stdin both parts", []string{"run", "1", "-"}, input, 0, "day  1 part 1: 11 "},
+		{"gzip stdin", []string{"run", "1", "2", "--input", "-"}, buf.String(), 0, "day  1 part 2: 31 "},
+		{"gzip file", []string{"run", "1", "1", "--input", gz}, "", 0, "day  1 part 1: 11 "},
+		{"parse error", []string{"run", "1", "1", "-"}, "1 2\n3 x\n", 1, ""},
+		{"stdin and input", []string{"run", "1", "-", "--input", gz}, "", 2, ""},
+		{"json", []string{"run", "1", "2", "-", "--format", "json"}, input, 0, `{"day":1,"part":2,"answer":"31","duration_ns":`},
+		{"json parse error", []string{"run", "1", "1", "-", "--format", "json"}, "1 2\n3 x\n", 1, `"error":"line 2, column 3: invalid number \"x\""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := stdin
+			stdin = strings.NewReader(tt.stdin)
+			defer func() { stdin = old }()
+
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stderr: %s)", code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantOut) {
+				t.Errorf("stdout %q does not contain %q", stdout.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestVerifyCommand(t *testing.T) {
+	t.Setenv("AOC_CACHE_DIR", t.TempDir())
+	input := []byte("3   4\n4   3\n2   5\n1   3\n3   9\n3   3\n")
+	root := t.TempDir()
+	dir := filepath.Join(root, "day01", "part1")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "input"), input, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	hash := answers.HashInput(input)
+
+	tests := []struct {
+		name        string
+		answers     string
+		args        []string
+		wantCode    int
+		wantOut     []string
+		wantAnswers string // 非空时检查运行后的答案文件
+	}{
+		{
+			name:     "pass",
+			answers:  "1 1 " + hash + " 11\n1 2 " + hash + " 31\n",
+			wantCode: 0,
+			wantOut:  []string{"day  1 part 1: pass     11 ", "2 passed, 0 failed, 0 missing"},
+		},
+		{
+			name:     "fail",
+			answers:  "1 1 " + hash + " 11\n1 2 " + hash + " 32\n",
+			wantCode: 1,
+			wantOut:  []string{"day  1 part 2: FAIL     got 31, want 32", "1 passed, 1 failed, 0 missing"},
+		},
+		{
+			name:     "answer recorded for a different input",
+			answers:  "1 1 0000 11\n",
+			wantCode: 0,
+			wantOut:  []string{"day  1 part 1: missing  no recorded answer", "0 passed, 0 failed, 2 missing"},
+		},
+		{
+			name:        "record",
+			answers:     "1 1 " + hash + " 11\n",
+			args:        []string{"--record"},
+			wantCode:    0,
+			wantOut:     []string{"day  1 part 2: recorded 31 ", "1 passed, 0 failed, 0 missing, 1 recorded"},
+			wantAnswers: "1 1 " + hash + " 11\n1 2 " + hash + " 31\n",
+		},
+		{
+			name:     "no input file",
+			args:     []string{"3"},
+			wantCode: 0,
+			wantOut:  []string{"day  3 part 2: missing  no input file"},
+		},
+		{
+			name:     "json",
+			answers:  "1 1 " + hash + " 11\n1 2 " + hash + " 32\n",
+			args:     []string{"--format", "json"},
+			wantCode: 1,
+			wantOut: []string{
+				`{"day":1,"part":1,"answer":"11",`,
+				`"diagnostics":{"status":"pass","want":"11"}}`,
+				`"diagnostics":{"status":"fail","want":"32"}}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), answers.DefaultFile)
+			if err := os.WriteFile(path, []byte(tt.answers), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			positional := tt.args
+			if len(positional) == 0 || strings.HasPrefix(positional[0], "-") {
+				positional = append([]string{"1"}, positional...)
+			}
+			args := append([]string{"verify", "--root", root, "--answers", path}, positional...)
+
+			var stdout, stderr bytes.Buffer
+			code := run(args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stdout: %s, stderr: %s)", code, tt.wantCode, stdout.String(), stderr.String())
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("stdout %q does not contain %q", stdout.String(), want)
+				}
+			}
+			if tt.wantAnswers != "" {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !strings.HasSuffix(string(data), tt.wantAnswers) {
+					t.Errorf("answers file =\n%s\nwant it to end with\n%s", data, tt.wantAnswers)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchCommand(t *testing.T) {
+	const example = "3   4\n4   3\n2   5\n1   3\n3   9\n3   3\n"
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if c, err := r.Cookie("session"); err != nil || c.Value != "token" {
+			http.Error(w, "Please log in", http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path != "/2024/day/1/input" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(example))
+	}))
+	defer srv.Close()
+	t.Setenv("AOC_CACHE_DIR", t.TempDir())
+	t.Setenv("AOC_SESSION", "token")
+
+	steps := []struct {
+		name         string
+		args         []string
+		wantCode     int
+		wantOut      string
+		wantRequests int // 到这一步为止服务器收到的请求总数
+	}{
+		{name: "download", args: []string{"fetch", "1"}, wantOut: "day  1: fetched ", wantRequests: 1},
+		{name: "cached", args: []string{"fetch", "1"}, wantOut: "day  1: cached ", wantRequests: 1},
+		{name: "force", args: []string{"fetch", "--force", "1"}, wantOut: "day  1: fetched ", wantRequests: 2},
+		{name: "locked day", args: []string{"fetch", "2"}, wantCode: 1, wantRequests: 3},
+		{name: "run falls back to the cache", args: []string{"run", "1", "1", "--root", t.TempDir()}, wantOut: "day  1 part 1: 11 ", wantRequests: 3},
+	}
+
+	for _, step := range steps {
+		var stdout, stderr bytes.Buffer
+		args := step.args
+		if args[0] == "fetch" {
+			args = append(args, "--url", srv.URL)
+		}
+		if code := run(args, &stdout, &stderr); code != step.wantCode {
+			t.Fatalf("%s: exit code = %d, want %d (stderr: %s)", step.name, code, step.wantCode, stderr.String())
+		}
+		if !strings.Contains(stdout.String(), step.wantOut) {
+			t.Errorf("%s: stdout %q does not contain %q", step.name, stdout.String(), step.wantOut)
+		}
+		if requests != step.wantRequests {
+			t.Errorf("%s: server saw %d requests, want %d", step.name, requests, step.wantRequests)
+		}
+	}
+}
+
+func TestSubmitCommand(t *testing.T) {
+	const (
+		right      = `<main><article><p>That's the right answer!  You are <span class="day-success">one gold star</span> closer.</p></article></main>`
+		tooHigh    = `<main><article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again.</p></article></main>`
+		wrongLevel = `<main><article><p>You don't seem to be solving the right level.  Did you already complete it? <a href="/2024/day/2">[Return to Day 2]</a></p></article></main>`
+	)
+	requests, locked := 0, true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/2024/day/2/answer" && locked {
+			// 第 2 天第二部分第一次提交时尚未解锁，之后解锁。
+			locked = false
+			w.Write([]byte(wrongLevel))
+		} else if r.PostFormValue("answer") == "31" {
+			w.Write([]byte(right))
+		} else {
+			w.Write([]byte(tooHigh))
+		}
+	}))
+	defer srv.Close()
+	t.Setenv("AOC_SESSION", "token")
+	logPath := filepath.Join(t.TempDir(), "attempts.log")
+
+	steps := []struct {
+		name         string
+		args         []string
+		wantCode     int
+		wantOut      string
+		wantRequests int // 到这一步为止服务器收到的请求总数
+	}{
+		{name: "too high", args: []string{"1", "1", "12"}, wantCode: 1, wantOut: "12 is too-high (wait 1m0s", wantRequests: 1},
+		{name: "same answer again", args: []string{"1", "1", "12"}, wantCode: 1, wantRequests: 1},
+		{name: "cooling down", args: []string{"1", "1", "11"}, wantCode: 1, wantRequests: 1},
+		{name: "right", args: []string{"1", "2", "31"}, wantCode: 0, wantOut: "31 is right", wantRequests: 2},
+		{name: "already solved", args: []string{"1", "2", "31"}, wantCode: 1, wantRequests: 2},
+		{name: "bad answer", args: []string{"1", "2", ""}, wantCode: 2, wantRequests: 2},
+		{name: "locked part", args: []string{"2", "2", "31"}, wantCode: 1, wantOut: "31 is already-solved", wantRequests: 3},
+		{name: "unlocked part", args: []string{"2", "2", "31"}, wantCode: 0, wantOut: "31 is right", wantRequests: 4},
+	}
+
+	for _, step := range steps {
+		var stdout, stderr bytes.Buffer
+		args := append([]string{"submit", "--url", srv.URL, "--log", logPath}, step.args...)
+		if code := run(args, &stdout, &stderr); code != step.wantCode {
+			t.Fatalf("%s: exit code = %d, want %d (stdout: %s, stderr: %s)", step.name, code, step.wantCode, stdout.String(), stderr.String())
+		}
+		if !strings.Contains(stdout.String(), step.wantOut) {
+			t.Errorf("%s: stdout %q does not contain %q", step.name, stdout.String(), step.wantOut)
+		}
+		if requests != step.wantRequests {
+			t.Errorf("%s: server saw %d requests, want %d", step.name, requests, step.wantRequests)
+		}
+	}
+}
+
+func TestBenchCommand(t *testing.T) {
+	t.Setenv("AOC_CACHE_DIR", t.TempDir())
+	root := t.TempDir()
+	dir := filepath.Join(root, "day01", "part1")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "input"), []byte("3   4\n4   3\n2   5\n1   3\n3   9\n3   3\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out := t.TempDir()
+	jsonPath, csvPath := filepath.Join(out, "bench.json"), filepath.Join(out, "bench.csv")
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"bench", "1", "-n", "2", "--root", root, "--json", jsonPath, "--csv", csvPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("exit code = %d (stderr: %s)", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "day  1 part 2: median ") {
+		t.Errorf("stdout = %q", stdout.String())
+	}
+	report, err := bench.Load(jsonPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Results) != 2 || report.Results[0].Runs != 2 {
+		t.Errorf("report results = %+v", report.Results)
+	}
+	if data, err := os.ReadFile(csvPath); err != nil || strings.Count(string(data), "\n") != 3 {
+		t.Errorf("CSV = %q, %v; want header and 2 rows", data, err)
+	}
+
+	// 把基线改成快得不可能的数字，应当报告回退。
+	for i := range report.Results {
+		report.Results[i].MedianNS = 1
+	}
+	if err := report.Save(jsonPath); err != nil {
+		t.Fatal(err)
+	}
+	stdout.Reset()
+	code = run([]string{"bench", "1", "1", "-n", "1", "--root", root, "--baseline", jsonPath}, &stdout, &stderr)
+	if code != 1 || !strings.Contains(stdout.String(), "REGRESSION day 1 part 1: median_ns") {
+		t.Errorf("exit code = %d, stdout = %q; want a median regression", code, stdout.String())
+	}
+}
+
+func TestVMCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input")
+	const input = "Register A: 729\nRegister B: 0\nRegister C: 0\n\nProgram: 0,1,5,4,3,0\n"
+	if err := os.WriteFile(path, []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		stdin    string
+		wantCode int
+		wantOut  string
+	}{
+		{"disasm", []string{"vm", "disasm", path}, "", 0, "  2  out A    ; output A % 8\n"},
+		{"asm", []string{"vm", "asm", "-"}, "0 adv 1\n2 out A\njnz 0\n", 0, "Program: 0,1,5,4,3,0\n"},
+		{"asm error", []string{"vm", "asm", "-"}, "adv 1\nout D\n", 1, ""},
+		{"run", []string{"vm", "run", path}, "", 0, "4,6,3,5,6,3,5,2,1,0\n"},
+		{"run compiled", []string{"vm", "run", path, "--compiled"}, "", 0, "4,6,3,5,6,3,5,2,1,0\n"},
+		{"run count", []string{"vm", "run", path, "--compiled", "--a", "7", "--count", "2"}, "", 0, "7: 3,1,0\n8: 4,2,1,0\n"},
+		{"run compiled budget", []string{"vm", "run", "-", "--compiled"}, "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 3,0\n", 1, ""},
+		{"run flags only for run", []string{"vm", "trace", path, "--compiled"}, "", 2, ""},
+		{"trace", []string{"vm", "trace", path}, "", 0, "  2  out A    A=364 B=0 C=0  out 4\n"},
+		{"trace output", []string{"vm", "trace", path}, "", 0, "output: 4,6,3,5,6,3,5,2,1,0\n"},
+		{"trace register override", []string{"vm", "trace", path, "--a", "2024"}, "", 0, "output: 4,2,5,6,7,7,7,7,3,1,0\n"},
+		{"trace breakpoint", []string{"vm", "trace", path, "--break", "4"}, "", 0, "breakpoint at 4 (jnz 0): ip=4 A=364"},
+		{"trace condition", []string{"vm", "trace", "-", "--when", "A<50"}, input, 0, "condition A < 50: ip=2 A=45"},
+		{"trace bad condition", []string{"vm", "trace", path, "--when", "A~1"}, "", 2, ""},
+		{"debug", []string{"vm", "debug", path}, "break 4\ncontinue\nregs\nstep 2\nquit\n", 0, "ip=4 A=364 B=0 C=0  executed=2  output: 4\n"},
+		{"debug error", []string{"vm", "debug", path}, "set D 1\n", 0, `error: unknown register "D"`},
+		{"debug from stdin", []string{"vm", "debug", "-"}, input, 2, ""},
+		{"unknown command", []string{"vm", "exec"}, "", 2, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := stdin
+			stdin = strings.NewReader(tt.stdin)
+			defer func() { stdin = old }()
+
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stderr: %s)", code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantOut) {
+				t.Errorf("stdout %q does not contain %q", stdout.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestCircuitCommand(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "input")
+	const input = "x00: 1\nx01: 0\ny00: 1\ny01: 1\n\nx00 XOR y00 -> z00\nx00 AND y00 -> c0\nx01 XOR y01 -> s1\ns1 XOR c0 -> z01\nx01 AND y01 -> a1\ns1 AND c0 -> t1\na1 AND t1 -> z02\n"
+	if err := os.WriteFile(path, []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	outPath := filepath.Join(dir, "adder.v")
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantOut  string
+	}{
+		{"dot", []string{"circuit", "export", path}, 0, `"s1" -> "z01" [label="s1"];`},
+		{"highlight", []string{"circuit", "export", path, "--highlight", "--group"}, 0, `"z02" [shape=box, label="AND\nz02", color=red`},
+		{"verilog", []string{"circuit", "export", path, "--format", "verilog", "--module", "top"}, 0, "assign z02 = a1 & t1;"},
+		{"bad format", []string{"circuit", "export", path, "--format", "vhdl"}, 2, ""},
+		{"no subcommand", []string{"circuit"}, 2, ""},
+		{"to file", []string{"circuit", "export", path, "--format", "verilog", "-o", outPath}, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stderr: %s)", code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantOut) {
+				t.Errorf("stdout %q does not contain %q", stdout.String(), tt.wantOut)
+			}
+		})
+	}
+	if data, err := os.ReadFile(outPath); err != nil || !strings.HasPrefix(string(data), "module adder (") {
+		t.Errorf("-o wrote %q, %v", data, err)
+	}
+}
+
+func TestLANCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input")
+	// 三角形 a-b-c，c 经桥 c-d 连到 d，d-e 是第二座桥；x-y 是另一个分量
+	const input = "a-b\nb-c\nc-a\nc-d\nd-e\nx-y\n"
+	if err := os.WriteFile(path, []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	empty := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(empty, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantOut  string
+	}{
+		{"components", []string{"lan", "components", path}, 0, "2 components\n   5: a,b,c,d,e\n   2: x,y\n"},
+		{"components json", []string{"lan", "components", path, "--format", "json"}, 0, `{"components":[["a","b","c","d","e"],["x","y"]]}`},
+		{"kcore", []string{"lan", "kcore", path}, 0, "degeneracy 2\n  2-core: 3 computers\n  1-core: 7 computers\n"},
+		{"kcore json", []string{"lan", "kcore", path, "--format", "json"}, 0, `"top":["a","b","c"]`},
+		{"degrees", []string{"lan", "degrees", path}, 0, "degree   1: 3 computers\ndegree   2: 3 computers\ndegree   3: 1 computers\n"},
+		{"path", []string{"lan", "path", "a", "e", path}, 0, "a -> c -> d -> e (3 hops)\n"},
+		{"path json", []string{"lan", "path", "e", "b", path, "--format", "json"}, 0, `{"from":"e","to":"b","hops":3,"path":["e","d","c","b"]}`},
+		{"path unreachable", []string{"lan", "path", "a", "x", path}, 1, ""},
+		{"path unknown", []string{"lan", "path", "a", "zz", path}, 1, ""},
+		{"path missing args", []string{"lan", "path", "a"}, 2, ""},
+		{"articulation", []string{"lan", "articulation", path}, 0, "2 articulation points\nc\nd\n"},
+		{"bridges json", []string{"lan", "bridges", path, "--format", "json"}, 0, `{"bridges":[["c","d"],["d","e"],["x","y"]]}`},
+		{"unknown", []string{"lan", "diameter", path}, 2, ""},
+		// 没有结果时 JSON 里是空列表而不是 null
+		{"components empty", []string{"lan", "components", empty, "--format", "json"}, 0, `{"components":[]}`},
+		{"kcore empty", []string{"lan", "kcore", empty, "--format", "json"}, 0, `"top":[]`},
+		{"degrees empty", []string{"lan", "degrees", empty, "--format", "json"}, 0, `{"histogram":[]}`},
+		{"articulation empty", []string{"lan", "articulation", empty, "--format", "json"}, 0, `{"articulation_points":[]}`},
+		{"bridges empty", []string{"lan", "bridges", empty, "--format", "json"}, 0, `{"bridges":[]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stderr: %s)", code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantOut) {
+				t.Errorf("stdout %q does not contain %q", stdout.String(), tt.wantOut)
+			}
+		})
+	}
+}
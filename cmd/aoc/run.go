@@ -0,0 +1,166 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"adventofcode/parse"
+	"adventofcode/registry"
+	"adventofcode/result"
+)
+
+// errNotImplemented 表示请求的 day/part 没有登记求解函数，对应退出码 1；
+// selectEntries 返回的其他错误都是用法错误，对应退出码 2。
+var errNotImplemented = errors.New("not implemented")
+
+// stdin 是 "--input -" 读取的标准输入，测试时可以替换。
+var stdin io.Reader = os.Stdin
+
+// runCommand 实现 "aoc run <day|all> [part] [-]"。最后一个位置参数为 "-" 时
+// 从标准输入读取谜题输入，等同于 "--input -"。
+func runCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	inputPath := fs.String("input", "", "read the puzzle input from this file (\"-\" for stdin, may be gzip-compressed) instead of dayNN/partN/input")
+	root := fs.String("root", ".", "repository root used to locate default input files")
+	format := result.Text
+	fs.Var(&format, "format", "output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: aoc run <day|all> [part] [-] [--input path] [--root dir] [--format text|json]")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if n := len(positional); n > 0 && positional[n-1] == "-" {
+		if *inputPath != "" {
+			fmt.Fprintln(stderr, "aoc run: - and --input cannot be combined")
+			return 2
+		}
+		*inputPath = "-"
+		positional = positional[:n-1]
+	}
+	if len(positional) < 1 || len(positional) > 2 {
+		fs.Usage()
+		return 2
+	}
+	if positional[0] == "all" && (len(positional) != 1 || *inputPath != "") {
+		fmt.Fprintln(stderr, "aoc run all: part and --input cannot be combined with all")
+		return 2
+	}
+
+	entries, err := selectEntries(registry.Calendar(), positional)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc run: %v\n", err)
+		return exitCodeFor(err)
+	}
+
+	read := func(e registry.Entry) ([]byte, error) { return readInput(*root, *inputPath, e) }
+	if *inputPath == "-" {
+		// 标准输入只能读一次，同一天的两部分共用读到的内容。
+		data, err := readInput(*root, *inputPath, entries[0])
+		if err != nil {
+			fmt.Fprintf(stderr, "aoc run: reading stdin: %v\n", err)
+			return 1
+		}
+		read = func(registry.Entry) ([]byte, error) { return data, nil }
+	}
+
+	failed := 0
+	for _, e := range entries {
+		r := result.Result{Day: e.Day, Part: e.Part}
+		if data, err := read(e); err != nil {
+			r.Err = err
+		} else {
+			r = result.Solve(e.Day, e.Part, e.Solve, string(data))
+		}
+		if r.Err != nil {
+			failed++
+		}
+		if err := result.Write(stdout, stderr, format, r); err != nil {
+			fmt.Fprintf(stderr, "aoc run: %v\n", err)
+			return 1
+		}
+	}
+
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// selectEntries 把 "<day|all> [part]" 形式的位置参数解析为要运行的条目。
+// 没有位置参数时等同于 "all"。
+func selectEntries(reg *registry.Registry, positional []string) ([]registry.Entry, error) {
+	if len(positional) == 0 || positional[0] == "all" {
+		if len(positional) > 1 {
+			return nil, errors.New("a part cannot be combined with all")
+		}
+		return reg.Entries(), nil
+	}
+	if len(positional) > 2 {
+		return nil, fmt.Errorf("unexpected arguments %q", positional[2:])
+	}
+
+	day, err := parseDay(positional[0])
+	if err != nil {
+		return nil, err
+	}
+	parts := reg.Parts(day)
+	if len(positional) == 2 {
+		part, err := parsePart(positional[1])
+		if err != nil {
+			return nil, err
+		}
+		parts = []int{part}
+	}
+
+	var entries []registry.Entry
+	for _, part := range parts {
+		solve, ok := reg.Lookup(day, part)
+		if !ok {
+			return nil, fmt.Errorf("day %d part %d is %w", day, part, errNotImplemented)
+		}
+		entries = append(entries, registry.Entry{Day: day, Part: part, Solve: solve})
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("day %d is %w", day, errNotImplemented)
+	}
+	return entries, nil
+}
+
+// exitCodeFor 返回 selectEntries 错误对应的退出码。
+func exitCodeFor(err error) int {
+	if errors.Is(err, errNotImplemented) {
+		return 1
+	}
+	return 2
+}
+
+// readInput 读取条目的谜题输入：指定了 inputPath 时读取该文件（"-" 为标准输入），
+// 否则读取 root 下的默认输入文件；仓库里没有时再找 "aoc fetch" 的缓存。
+// gzip 压缩的输入会被自动解压。
+func readInput(root, inputPath string, e registry.Entry) ([]byte, error) {
+	switch inputPath {
+	case "":
+	case "-":
+		return parse.ReadAll(stdin)
+	default:
+		return parse.ReadFile(inputPath)
+	}
+	path, err := defaultInputPath(root, e.Day, e.Part)
+	if errors.Is(err, errNoInput) {
+		if data, ok := cachedInput(e.Day); ok {
+			return data, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parse.ReadFile(path)
+}
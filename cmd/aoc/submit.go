@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"adventofcode/puzzle"
+)
+
+// submitCommand 实现 "aoc submit <day> <part> <answer>"。
+// 提交前先查本地的提交记录：已知错误的答案、已解出的部分和冷却中的提交
+// 都会被拒绝，不会发到站点。每次实际提交都会追加到记录中。
+func submitCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("submit", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	year := fs.Int("year", puzzle.DefaultYear, "puzzle year")
+	baseURL := fs.String("url", puzzle.DefaultBaseURL, "puzzle site base URL")
+	logPath := fs.String("log", "", "attempt log (default: attempts.log next to the input cache)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: aoc submit <day> <part> <answer> [--year n] [--url base] [--log path]")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 3 {
+		fs.Usage()
+		return 2
+	}
+	day, err := parseDay(positional[0])
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc submit: %v\n", err)
+		return 2
+	}
+	part, err := parsePart(positional[1])
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc submit: %v\n", err)
+		return 2
+	}
+	answer := positional[2]
+	if answer == "" || strings.ContainsAny(answer, " \t\r\n") {
+		fmt.Fprintf(stderr, "aoc submit: invalid answer %q\n", answer)
+		return 2
+	}
+
+	if *logPath == "" {
+		if *logPath, err = puzzle.DefaultAttemptLog(*year); err != nil {
+			fmt.Fprintf(stderr, "aoc submit: %v\n", err)
+			return 1
+		}
+	}
+	attempts, err := puzzle.OpenAttempts(*logPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc submit: %v\n", err)
+		return 1
+	}
+	if err := attempts.Check(day, part, answer, time.Now()); err != nil {
+		fmt.Fprintf(stderr, "aoc submit: not submitting: %v\n", err)
+		return 1
+	}
+
+	session, err := puzzle.LoadSession()
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc submit: %v\n", err)
+		return 1
+	}
+	client := puzzle.New(session)
+	client.BaseURL = *baseURL
+	client.Year = *year
+
+	resp, err := client.Submit(context.Background(), day, part, answer)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc submit: %v\n", err)
+		return 1
+	}
+	attempt := puzzle.Attempt{Time: time.Now(), Day: day, Part: part, Answer: answer, Verdict: resp.Verdict, Wait: resp.Wait}
+	if err := attempts.Record(attempt); err != nil {
+		fmt.Fprintf(stderr, "aoc submit: recording attempt: %v\n", err)
+	}
+
+	fmt.Fprintf(stdout, "day %d part %d: %s is %s", day, part, answer, resp.Verdict)
+	if resp.Wait > 0 {
+		fmt.Fprintf(stdout, " (wait %s before the next attempt)", resp.Wait)
+	}
+	fmt.Fprintf(stdout, "\n%s\n", resp.Message)
+
+	switch resp.Verdict {
+	case puzzle.Correct:
+		return 0
+	case puzzle.Unknown:
+		fmt.Fprintln(stderr, "aoc submit: could not understand the response page")
+	}
+	return 1
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"adventofcode/answers"
+	"adventofcode/registry"
+	"adventofcode/result"
+)
+
+// verifyCommand 实现 "aoc verify [day [part]]"：用默认输入运行求解函数，
+// 并与答案文件中针对同一份输入记录的答案比较。
+// 有任何 FAIL 时退出码为 1，可以用来把关合并。
+func verifyCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	root := fs.String("root", ".", "repository root used to locate default input files")
+	answersPath := fs.String("answers", "", "answers file (default <root>/"+answers.DefaultFile+")")
+	record := fs.Bool("record", false, "record the current answer for every entry that has none yet")
+	format := result.Text
+	fs.Var(&format, "format", "output format: text or json (json omits the summary line)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: aoc verify [day|all] [part] [--root dir] [--answers path] [--record] [--format text|json]")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	entries, err := selectEntries(registry.Calendar(), positional)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc verify: %v\n", err)
+		return exitCodeFor(err)
+	}
+
+	if *answersPath == "" {
+		*answersPath = filepath.Join(*root, answers.DefaultFile)
+	}
+	book, err := answers.Load(*answersPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc verify: %v\n", err)
+		return 1
+	}
+
+	var passed, failed, missing, recorded int
+	// report 输出一个条目的核对结果。文本格式为 "day  N part P: <status> <detail>"；
+	// JSON 格式把状态和期望答案作为诊断信息附在求解结果上。
+	report := func(r result.Result, status, detail string) {
+		switch status {
+		case "pass":
+			passed++
+		case "FAIL":
+			failed++
+		case "missing":
+			missing++
+		case "recorded":
+			recorded++
+		}
+		if format == result.JSON {
+			r.Note("status", "%s", strings.ToLower(status))
+			result.Write(stdout, stderr, format, r)
+			return
+		}
+		fmt.Fprintf(stdout, "day %2d part %d: %-8s %s\n", r.Day, r.Part, status, detail)
+	}
+
+	for _, e := range entries {
+		data, err := readInput(*root, "", e)
+		if err != nil {
+			r := result.Result{Day: e.Day, Part: e.Part, Err: err}
+			if errors.Is(err, errNoInput) {
+				report(r, "missing", "no input file")
+			} else {
+				report(r, "FAIL", err.Error())
+			}
+			continue
+		}
+
+		r := result.Solve(e.Day, e.Part, e.Solve, string(data))
+		took := r.Duration.Round(time.Microsecond)
+		if r.Err != nil {
+			report(r, "FAIL", fmt.Sprintf("%v (%s)", r.Err, took))
+			continue
+		}
+
+		key := answers.Key{Day: e.Day, Part: e.Part, InputHash: answers.HashInput(data)}
+		want, ok := book.Lookup(key)
+		if ok {
+			r.Note("want", "%s", want)
+		}
+		switch {
+		case ok && r.Answer == want:
+			report(r, "pass", fmt.Sprintf("%s (%s)", r.Answer, took))
+		case ok:
+			report(r, "FAIL", fmt.Sprintf("got %s, want %s (%s)", r.Answer, want, took))
+		case *record:
+			if err := book.Record(key, r.Answer); err != nil {
+				r.Err = fmt.Errorf("cannot record %q: %w", r.Answer, err)
+				report(r, "FAIL", r.Err.Error())
+				continue
+			}
+			report(r, "recorded", fmt.Sprintf("%s (%s)", r.Answer, took))
+		default:
+			r.Note("input_hash", "%s", key.InputHash)
+			report(r, "missing", fmt.Sprintf("no recorded answer for input %.12s (got %s, %s)", key.InputHash, r.Answer, took))
+		}
+	}
+
+	if recorded > 0 {
+		if err := book.Save(*answersPath); err != nil {
+			fmt.Fprintf(stderr, "aoc verify: %v\n", err)
+			return 1
+		}
+	}
+
+	if format == result.Text {
+		fmt.Fprintf(stdout, "%d passed, %d failed, %d missing", passed, failed, missing)
+		if recorded > 0 {
+			fmt.Fprintf(stdout, ", %d recorded", recorded)
+		}
+		fmt.Fprintln(stdout)
+	}
+
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
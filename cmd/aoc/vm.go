@@ -0,0 +1,349 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"adventofcode/day17"
+	"adventofcode/registry"
+	"adventofcode/vm"
+)
+
+const vmUsage = `usage: aoc vm <command> [path|-] [flags]
+
+commands:
+  disasm   print the program of a day 17 input as an assembly listing
+  run      run the program and print its output (--compiled runs it as Go closures)
+  asm      assemble a listing back into a "Program:" line
+  trace    run the program and print the registers after every instruction
+  debug    step through the program interactively (commands are read from stdin)
+
+path defaults to the day 17 puzzle input; "-" reads stdin.
+`
+
+// vmCommand 实现 "aoc vm"：第 17 天 3 位计算机的反汇编、汇编、跟踪和调试。
+func vmCommand(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, vmUsage)
+		return 2
+	}
+	switch args[0] {
+	case "disasm", "asm", "run", "trace", "debug":
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, vmUsage)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "aoc vm: unknown command %q\n\n%s", args[0], vmUsage)
+		return 2
+	}
+	cmd := args[0]
+
+	fs := flag.NewFlagSet("vm "+cmd, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	root := fs.String("root", ".", "repository root used to locate the default input file")
+	var registers [3]*int
+	var breakpoints []int
+	var conditions []vm.Condition
+	if cmd == "run" || cmd == "trace" || cmd == "debug" {
+		for i, name := range []string{"a", "b", "c"} {
+			registers[i] = fs.Int(name, 0, "override the initial value of register "+strings.ToUpper(name))
+		}
+	}
+	compiled, count := false, 1
+	if cmd == "run" {
+		fs.BoolVar(&compiled, "compiled", false, "compile the program to Go closures before running it")
+		fs.IntVar(&count, "count", 1, "run the program for this many consecutive values of A, one output line each")
+	}
+	if cmd == "trace" || cmd == "debug" {
+		fs.Func("break", "stop before executing the instruction at this address (repeatable)", func(s string) error {
+			ip, err := strconv.Atoi(s)
+			if err != nil || ip < 0 {
+				return fmt.Errorf("invalid address %q", s)
+			}
+			breakpoints = append(breakpoints, ip)
+			return nil
+		})
+		fs.Func("when", "stop after an instruction that makes a condition such as A==0 or B>7 true (repeatable)", func(s string) error {
+			c, err := vm.ParseCondition(s)
+			if err != nil {
+				return err
+			}
+			conditions = append(conditions, c)
+			return nil
+		})
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: aoc vm %s [path|-] [flags]\n", cmd)
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		return 2
+	}
+	if len(positional) > 1 {
+		fs.Usage()
+		return 2
+	}
+	inputPath := ""
+	if len(positional) == 1 {
+		inputPath = positional[0]
+	}
+	if cmd == "debug" && inputPath == "-" {
+		fmt.Fprintln(stderr, "aoc vm debug: the program cannot be read from stdin, which carries the debugger commands")
+		return 2
+	}
+	data, err := readInput(*root, inputPath, registry.Entry{Day: 17, Part: 1})
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc vm %s: %v\n", cmd, err)
+		return 1
+	}
+
+	if cmd == "asm" {
+		program, err := vm.Assemble(string(data))
+		if err != nil {
+			fmt.Fprintf(stderr, "aoc vm asm: %v\n", err)
+			return 1
+		}
+		fmt.Fprintln(stdout, vm.FormatProgram(program))
+		return 0
+	}
+
+	c, err := day17.Parse(strings.NewReader(string(data)))
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc vm %s: %v\n", cmd, err)
+		return 1
+	}
+	if cmd == "disasm" {
+		if err := vm.Disassemble(stdout, c.Program); err != nil {
+			fmt.Fprintf(stderr, "aoc vm disasm: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	// 命令行上给出的寄存器覆盖输入中的初始值。
+	initial := []*int{&c.A, &c.B, &c.C}
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "a", "b", "c":
+			i := f.Name[0] - 'a'
+			*initial[i] = *registers[i]
+		}
+	})
+	m, err := vm.New(c.Program, c.A, c.B, c.C)
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc vm %s: %v\n", cmd, err)
+		return 1
+	}
+	m.Budget = vmBudget
+	if cmd == "run" {
+		return vmRun(m, compiled, count, stdout, stderr)
+	}
+	d := &vm.Debugger{Machine: m, Breakpoints: breakpoints, Conditions: conditions}
+
+	if cmd == "trace" {
+		return vmTrace(d, stdout, stderr)
+	}
+	return vmDebug(d, stdin, stdout, stderr)
+}
+
+// vmBudget 是 run、trace 和 debug 每次运行最多执行的指令条数。
+const vmBudget = 1 << 20
+
+// vmRun 以 m 的初始寄存器运行程序并输出结果。count 大于 1 时依次运行
+// A, A+1, …, A+count-1，每个 A 输出一行，适合观察输出随 A 的变化。
+func vmRun(m *vm.Machine, compiled bool, count int, stdout, stderr io.Writer) int {
+	if count < 1 {
+		fmt.Fprintln(stderr, "aoc vm run: --count must be at least 1")
+		return 2
+	}
+	run := func(a int, buf []int) ([]int, error) {
+		fresh := *m
+		fresh.A = a
+		return fresh.Run()
+	}
+	if compiled {
+		p, err := vm.Compile(m.Program)
+		if err != nil {
+			fmt.Fprintf(stderr, "aoc vm run: %v\n", err)
+			return 1
+		}
+		p.Budget = m.Budget
+		run = func(a int, buf []int) ([]int, error) { return p.AppendOutput(buf[:0], a, m.B, m.C) }
+	}
+
+	var buf []int
+	for a := m.A; a < m.A+count; a++ {
+		var err error
+		buf, err = run(a, buf)
+		if err != nil {
+			fmt.Fprintf(stderr, "aoc vm run: A=%d: %v\n", a, err)
+			return 1
+		}
+		if count == 1 {
+			fmt.Fprintln(stdout, vm.Join(buf))
+		} else {
+			fmt.Fprintf(stdout, "%d: %s\n", a, vm.Join(buf))
+		}
+	}
+	return 0
+}
+
+// vmTrace 运行到停机或第一次停下，每条指令输出一行。
+func vmTrace(d *vm.Debugger, stdout, stderr io.Writer) int {
+	d.Trace = stdout
+	fmt.Fprintf(stdout, "start  %s\n", d.State())
+	if d.Halted() {
+		fmt.Fprintln(stdout, "halted")
+		return 0
+	}
+	reason, cond, err := d.Continue()
+	if err != nil {
+		fmt.Fprintf(stderr, "aoc vm trace: %v\n", err)
+		return 1
+	}
+	fmt.Fprintln(stdout, stopMessage(d, reason, cond))
+	fmt.Fprintf(stdout, "output: %s\n", vm.Join(d.Output))
+	return 0
+}
+
+// stopMessage 描述 Continue 停下的原因和位置。
+func stopMessage(d *vm.Debugger, reason vm.StopReason, cond *vm.Condition) string {
+	switch reason {
+	case vm.Breakpoint:
+		in, _ := d.Next()
+		return fmt.Sprintf("breakpoint at %d (%v): %s", d.IP, in, d.State())
+	case vm.Watch:
+		return fmt.Sprintf("condition %v: %s", cond, d.State())
+	}
+	return fmt.Sprintf("halted after %d instructions: %s", d.Executed, d.State())
+}
+
+const debugHelp = `commands:
+  s, step [n]        execute n instructions (default 1), tracing each one
+  c, continue        run until a breakpoint, a condition or the end of the program
+  b, break <ip>      stop before the instruction at ip
+  w, when <cond>     stop after an instruction that makes cond true, e.g. "when A==0"
+  d, delete          remove all breakpoints and conditions
+  r, regs            print the registers and the output so far
+  set <reg> <value>  change register A, B, C or ip
+  l, list            print the program, marking the next instruction
+  q, quit            leave the debugger
+`
+
+// vmDebug 从 in 逐行读取调试命令并执行，直到 quit 或输入结束。
+func vmDebug(d *vm.Debugger, in io.Reader, stdout, stderr io.Writer) int {
+	d.Trace = stdout
+	fmt.Fprintf(stdout, "%s\ntype help for a list of commands\n", d.State())
+	scanner := bufio.NewScanner(in)
+	for fmt.Fprint(stdout, "(vm) "); scanner.Scan(); fmt.Fprint(stdout, "(vm) ") {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		quit, err := debugCommand(d, fields, stdout)
+		if err != nil {
+			fmt.Fprintf(stdout, "error: %v\n", err)
+		}
+		if quit {
+			return 0
+		}
+	}
+	fmt.Fprintln(stdout)
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "aoc vm debug: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// debugCommand 执行一条调试命令，quit 时返回 true。
+func debugCommand(d *vm.Debugger, fields []string, stdout io.Writer) (quit bool, err error) {
+	args := fields[1:]
+	switch fields[0] {
+	case "s", "step":
+		n := 1
+		if len(args) > 0 {
+			if n, err = strconv.Atoi(args[0]); err != nil || n < 1 {
+				return false, fmt.Errorf("invalid count %q", args[0])
+			}
+		}
+		for range n {
+			if err := d.Step(); err != nil {
+				return false, err
+			}
+			if d.Halted() {
+				fmt.Fprintln(stdout, stopMessage(d, vm.Halted, nil))
+				break
+			}
+		}
+	case "c", "continue":
+		reason, cond, err := d.Continue()
+		if err != nil {
+			return false, err
+		}
+		fmt.Fprintln(stdout, stopMessage(d, reason, cond))
+	case "b", "break":
+		if len(args) != 1 {
+			return false, errors.New("usage: break <ip>")
+		}
+		ip, err := strconv.Atoi(args[0])
+		if err != nil || ip < 0 {
+			return false, fmt.Errorf("invalid address %q", args[0])
+		}
+		d.Breakpoints = append(d.Breakpoints, ip)
+	case "w", "when":
+		c, err := vm.ParseCondition(strings.Join(args, ""))
+		if err != nil {
+			return false, err
+		}
+		d.Conditions = append(d.Conditions, c)
+	case "d", "delete":
+		d.Breakpoints, d.Conditions = nil, nil
+	case "r", "regs":
+		fmt.Fprintf(stdout, "%s  executed=%d  output: %s\n", d.State(), d.Executed, vm.Join(d.Output))
+	case "set":
+		if len(args) != 2 {
+			return false, errors.New("usage: set <reg> <value>")
+		}
+		v, err := strconv.Atoi(args[1])
+		if err != nil {
+			return false, fmt.Errorf("invalid value %q", args[1])
+		}
+		switch strings.ToUpper(args[0]) {
+		case "A":
+			d.A = v
+		case "B":
+			d.B = v
+		case "C":
+			d.C = v
+		case "IP":
+			d.IP = v
+		default:
+			return false, fmt.Errorf("unknown register %q", args[0])
+		}
+	case "l", "list":
+		var listing strings.Builder
+		vm.Disassemble(&listing, d.Program)
+		for line := range strings.Lines(listing.String()) {
+			marker := "  "
+			if ip, _ := strconv.Atoi(strings.Fields(line)[0]); ip == d.IP {
+				marker = "=>"
+			}
+			fmt.Fprint(stdout, marker, line)
+		}
+	case "help", "h", "?":
+		fmt.Fprint(stdout, debugHelp)
+	case "q", "quit":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown command %q (type help for a list)", fields[0])
+	}
+	return false, nil
+}
@@ -0,0 +1,39 @@
+// Package day01 实现第 1 天（Historian Hysteria）的两部分求解。
+package day01
+
+import (
+	"io"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// Lists 是谜题输入中的左右两列数字。
+type Lists struct {
+	Left, Right []int
+}
+
+// Parse 从 r 读取谜题输入：每行两个以空白分隔的整数，空行会被忽略。
+func Parse(r io.Reader) (Lists, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return Lists{}, err
+	}
+
+	var lists Lists
+	for lineNo, line := range parse.Lines(text) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		nums, err := parse.Ints(lineNo, line, nil)
+		if err != nil {
+			return Lists{}, err
+		}
+		if len(nums) != 2 {
+			return Lists{}, parse.Errorf(lineNo, 0, "want 2 numbers, got %d", len(nums))
+		}
+		lists.Left = append(lists.Left, nums[0])
+		lists.Right = append(lists.Right, nums[1])
+	}
+	return lists, nil
+}
@@ -0,0 +1,29 @@
+package day01
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Part1 返回左右两列排序后逐项差值的总和。
+func Part1(input string) (int, error) {
+	lists, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return totalDistance(lists.Left, lists.Right), nil
+}
+
+// totalDistance 将左右列分别排序后重新组合，并返回差值总和（绝对值）
+func totalDistance(lefts, rights []int) int {
+	sort.Ints(lefts)
+	sort.Ints(rights)
+
+	total := 0
+	for i := 0; i < len(lefts) && i < len(rights); i++ {
+		diff := int(math.Abs(float64(rights[i] - lefts[i])))
+		total += diff
+	}
+	return total
+}
@@ -0,0 +1,31 @@
+package day01
+
+import "strings"
+
+// Part2 返回左列数字按其在右列出现次数加权后的相似度分数。
+func Part2(input string) (int, error) {
+	lists, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return solvePart2(lists.Left, lists.Right), nil
+}
+
+// solvePart2 计算相似度分数
+func solvePart2(leftList, rightList []int) int {
+	// 为右侧列表创建一个频率映射
+	rightCounts := make(map[int]int)
+	for _, num := range rightList {
+		rightCounts[num]++
+	}
+
+	var totalScore int
+	// 遍历左侧列表并计算分数
+	for _, num := range leftList {
+		// 如果数字不在映射中，其计数值将为0
+		count := rightCounts[num]
+		totalScore += num * count
+	}
+
+	return totalScore
+}
@@ -0,0 +1,141 @@
+// Package day02 实现第 2 天（Red-Nosed Reports）的求解，目前只有第二部分。
+package day02
+
+import (
+	"io"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+type LineEvaluation int
+
+const (
+	EvalSafe LineEvaluation = iota
+	EvalUnsafe
+)
+
+func (e LineEvaluation) String() string {
+	switch e {
+	case EvalSafe:
+		return "safe"
+	case EvalUnsafe:
+		return "unsafe"
+	default:
+		return "unknown"
+	}
+}
+
+// Part2 返回在允许删除一个数字的前提下安全的报告数量。
+func Part2(input string) (int, error) {
+	safe, _, err := countReports(input)
+	return safe, err
+}
+
+// Parse 从 r 读取谜题输入：每行一份报告，至少包含两个以空白分隔的整数。
+func Parse(r io.Reader) ([][]int, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	var reports [][]int
+	for lineNo, line := range parse.Lines(text) {
+		nums, err := parseReport(lineNo, line)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, nums)
+	}
+	return reports, nil
+}
+
+// parseReport 解析一份报告；第 lineNo 行用于错误信息。
+func parseReport(lineNo int, line string) ([]int, error) {
+	nums, err := parse.Ints(lineNo, line, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(nums) < 2 {
+		return nil, parse.Errorf(lineNo, 0, "not enough numbers to evaluate")
+	}
+	return nums, nil
+}
+
+// countReports 分析输入，返回 safe 和 unsafe 行的数量
+func countReports(input string) (int, int, error) {
+	reports, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, 0, err
+	}
+	var safeCount, unsafeCount int
+	for _, nums := range reports {
+		if evaluate(nums) == EvalSafe {
+			safeCount++
+		} else {
+			unsafeCount++
+		}
+	}
+	return safeCount, unsafeCount, nil
+}
+
+// parseLine 解析并评估一行数据
+func parseLine(line string) (LineEvaluation, error) {
+	nums, err := parseReport(1, line)
+	if err != nil {
+		return EvalUnsafe, err
+	}
+	return evaluate(nums), nil
+}
+
+// evaluate 判断一份报告在最多删除一个数字后是否安全
+func evaluate(nums []int) LineEvaluation {
+	if isSafe(nums) {
+		return EvalSafe
+	}
+
+	// 尝试删除一个数字来看看是否变为安全
+	for i := 0; i < len(nums); i++ {
+		tmp := append([]int{}, nums[:i]...)
+		tmp = append(tmp, nums[i+1:]...)
+		if len(tmp) >= 2 && isSafe(tmp) {
+			return EvalSafe
+		}
+	}
+
+	return EvalUnsafe
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// isSafe 判断一个数列是否符合安全条件
+func isSafe(nums []int) bool {
+	if len(nums) < 2 {
+		return false
+	}
+
+	direction := 0 // 0 = 未确定, 1 = 递增, -1 = 递减
+
+	for i := 1; i < len(nums); i++ {
+		diff := nums[i] - nums[i-1]
+		if diff == 0 || abs(diff) > 3 {
+			return false
+		}
+		if direction == 0 {
+			if diff > 0 {
+				direction = 1
+			} else {
+				direction = -1
+			}
+		} else {
+			if (direction == 1 && diff < 0) || (direction == -1 && diff > 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"adventofcode/day02"
+	"adventofcode/result"
+)
+
+func main() {
+	result.Main(2, 2, day02.Part2)
+}
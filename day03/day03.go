@@ -0,0 +1,86 @@
+// Package day03 实现第 3 天（Mull It Over）的求解，目前只有第二部分。
+package day03
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// Op 是损坏内存中可以识别的指令种类。
+type Op int
+
+const (
+	Mul  Op = iota // mul(x,y)
+	Do             // do()
+	Dont           // don't()
+)
+
+// Instruction 是从损坏内存中识别出的一条指令，其余字符都是噪声。
+type Instruction struct {
+	Op   Op
+	X, Y int // 仅对 Mul 有意义
+	// Line、Col 是指令在输入中的起始位置。
+	Line, Col int
+}
+
+var instructionRe = regexp.MustCompile(`mul\((\d+),(\d+)\)|do\(\)|don't\(\)`)
+
+// Parse 从 r 读取损坏的内存，按出现顺序返回其中的有效指令。
+// 内存中的其他内容都是噪声，因此除了读取失败外不会返回错误。
+func Parse(r io.Reader) ([]Instruction, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	var program []Instruction
+	for lineNo, line := range parse.Lines(text) {
+		for _, m := range instructionRe.FindAllStringSubmatchIndex(line, -1) {
+			in := Instruction{Line: lineNo, Col: parse.Col(line, m[0])}
+			switch line[m[0]:m[1]] {
+			case "do()":
+				in.Op = Do
+			case "don't()":
+				in.Op = Dont
+			default:
+				in.Op = Mul
+				in.X, _ = strconv.Atoi(line[m[2]:m[3]])
+				in.Y, _ = strconv.Atoi(line[m[4]:m[5]])
+			}
+			program = append(program, in)
+		}
+	}
+	return program, nil
+}
+
+// Part2 返回所有处于启用状态的 mul(x,y) 乘积之和。
+func Part2(input string) (int, error) {
+	program, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return sumEnabledMul(program), nil
+}
+
+// sumEnabledMul 累加所有处于启用状态的 mul 指令的乘积：
+// don't() 之后的 mul 被禁用，直到下一个 do() 重新启用
+func sumEnabledMul(program []Instruction) int {
+	sum := 0
+	enabled := true
+	for _, in := range program {
+		switch in.Op {
+		case Do:
+			enabled = true
+		case Dont:
+			enabled = false
+		case Mul:
+			if enabled {
+				sum += in.X * in.Y
+			}
+		}
+	}
+	return sum
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"adventofcode/day03"
+	"adventofcode/result"
+)
+
+func main() {
+	result.Main(3, 2, day03.Part2)
+}
@@ -1,30 +1,42 @@
-package main
+// Package day04 实现第 4 天（Ceres Search）的求解，目前只有第二部分。
+package day04
 
 import (
-	"fmt"
-	"os"
+	"io"
 	"strings"
+
+	"adventofcode/parse"
 )
 
-// readInput reads the input file and builds the character grid.
-// 读取输入文件并构建字符网格。
-func readInput(filename string) ([][]string, error) {
-	data, err := os.ReadFile(filename)
+// Parse reads the word search from r. Every row must have the same length.
+// 从 r 读取字符网格，各行长度必须一致。
+func Parse(r io.Reader) ([][]string, error) {
+	text, err := parse.Text(r)
 	if err != nil {
 		return nil, err
 	}
 
-	lines := strings.Split(string(data), "\n")
-	grid := make([][]string, len(lines))
-
-	for i, line := range lines {
-		line = strings.TrimSuffix(line, "\r") // Handle potential CR characters from Windows-style line endings
-		grid[i] = strings.Split(line, "")
+	var grid [][]string
+	for lineNo, line := range parse.Lines(text) {
+		row := strings.Split(line, "")
+		if len(grid) > 0 && len(row) != len(grid[0]) {
+			return nil, parse.Errorf(lineNo, min(len(row), len(grid[0]))+1,
+				"row has length %d, want %d", len(row), len(grid[0]))
+		}
+		grid = append(grid, row)
 	}
-
 	return grid, nil
 }
 
+// Part2 counts the X-MAS patterns in the word search.
+func Part2(input string) (int, error) {
+	grid, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findXMASPatterns(grid), nil
+}
+
 // findXMASPatterns counts the number of X-MAS patterns in the grid.
 // An X-MAS pattern is two "MAS" strings (forwards or backwards) intersecting at 'A'
 // in an X shape. Example: M.S
@@ -71,16 +83,3 @@ func findXMASPatterns(grid [][]string) int {
 	}
 	return count
 }
-
-func main() {
-	const inputFile = "/home/ubuntu/adventofcode/day04/input"
-	grid, err := readInput(inputFile)
-	if err != nil {
-		fmt.Printf("Failed to read input (%s): %v\n", inputFile, err)
-		return
-	}
-
-	// --- Part Two: Find X-MAS patterns ---
-	totalXMASPatterns := findXMASPatterns(grid)
-	fmt.Printf("Number of X-MAS patterns found: %d\n", totalXMASPatterns)
-}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"adventofcode/day04"
+	"adventofcode/result"
+)
+
+func main() {
+	result.Main(4, 2, day04.Part2)
+}
@@ -1,11 +1,12 @@
-package main
+// Package day05 implements day 5 (Print Queue); only part two is solved so far.
+package day05
 
 import (
-	"bufio"
-	"fmt"
-	"os"
-	"strconv"
+	"io"
 	"strings"
+	"unicode"
+
+	"adventofcode/parse"
 )
 
 // Rule represents a dependency rule where element A must come before element B
@@ -23,86 +24,58 @@ type InputData struct {
 	Updates []Update
 }
 
-// ReadInput parses the input file and returns the rules and updates
-func ReadInput(filename string) (*InputData, error) {
-	file, err := os.Open(filename)
+// Parse reads the puzzle input from r: ordering rules in the format "A|B",
+// then updates in the format "a,b,c,...". Blank lines are ignored.
+func Parse(r io.Reader) (*InputData, error) {
+	text, err := parse.Text(r)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+		return nil, err
 	}
-	defer file.Close()
 
 	data := &InputData{
 		Rules:   []Rule{},
 		Updates: []Update{},
 	}
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
+	for lineNo, line := range parse.Lines(text) {
+		if strings.TrimSpace(line) == "" {
 			continue
 		}
 
 		if strings.Contains(line, "|") {
-			rule, err := parseRule(line)
+			rule, err := parseRule(lineNo, line)
 			if err != nil {
-				fmt.Printf("Warning: %v\n", err)
-				continue
+				return nil, err
 			}
 			data.Rules = append(data.Rules, rule)
-		} else if strings.Contains(line, ",") {
-			update, err := parseUpdate(line)
+		} else {
+			update, err := parse.Ints(lineNo, line, parse.Comma)
 			if err != nil {
-				fmt.Printf("Warning: %v\n", err)
-				continue
+				return nil, err
 			}
 			data.Updates = append(data.Updates, update)
 		}
 	}
-
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading file: %w", err)
-	}
-
 	return data, nil
 }
 
 // parseRule parses a rule line in the format "A|B"
-func parseRule(line string) (Rule, error) {
-	parts := strings.Split(line, "|")
-	if len(parts) != 2 {
-		return Rule{}, fmt.Errorf("invalid rule format: %s", line)
+func parseRule(lineNo int, line string) (Rule, error) {
+	fields := parse.Fields(line, func(r rune) bool { return r == '|' || unicode.IsSpace(r) })
+	if len(fields) != 2 {
+		return Rule{}, parse.Errorf(lineNo, 0, "invalid rule format %q, want A|B", line)
 	}
 
-	a, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	a, err := fields[0].Int(lineNo)
 	if err != nil {
-		return Rule{}, fmt.Errorf("invalid rule integer A: %s", parts[0])
+		return Rule{}, err
 	}
-
-	b, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	b, err := fields[1].Int(lineNo)
 	if err != nil {
-		return Rule{}, fmt.Errorf("invalid rule integer B: %s", parts[1])
+		return Rule{}, err
 	}
-
 	return Rule{A: a, B: b}, nil
 }
 
-// parseUpdate parses an update line in the format "a, b, c, ..."
-func parseUpdate(line string) (Update, error) {
-	parts := strings.Split(line, ",")
-	update := make(Update, 0, len(parts))
-
-	for _, p := range parts {
-		num, err := strconv.Atoi(strings.TrimSpace(p))
-		if err != nil {
-			return nil, fmt.Errorf("invalid update integer: %s", p)
-		}
-		update = append(update, num)
-	}
-
-	return update, nil
-}
-
 // IsValid checks if an update satisfies all rules
 func (u Update) IsValid(rules []Rule) bool {
 	positions := make(map[int]int)
@@ -201,15 +174,12 @@ func ProcessUpdates(data *InputData) int {
 	return sum
 }
 
-func main() {
-	const inputFile = "input"
-
-	data, err := ReadInput(inputFile)
+// Part2 returns the sum of the middle pages of the incorrectly ordered updates
+// after putting them in the right order.
+func Part2(input string) (int, error) {
+	data, err := Parse(strings.NewReader(input))
 	if err != nil {
-		fmt.Printf("Error reading input: %v\n", err)
-		return
+		return 0, err
 	}
-
-	result := ProcessUpdates(data)
-	fmt.Println("Sum of middle elements:", result)
+	return ProcessUpdates(data), nil
 }
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"adventofcode/day05"
+	"adventofcode/result"
+)
+
+func main() {
+	result.Main(5, 2, day05.Part2)
+}
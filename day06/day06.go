@@ -0,0 +1,41 @@
+// Package day06 实现第 6 天（Guard Gallivant）的两部分求解。
+package day06
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/parse"
+)
+
+// guardMarkers 按 grid.Dirs4 的顺序（上、右、下、左）列出警卫的朝向字符，
+// 因此向右转就是把方向下标加一。
+const guardMarkers = "^>v<"
+
+// Lab 是解析后的谜题输入：实验室地图，以及警卫的初始位置和方向（grid.Dirs4 的下标）。
+// 地图中保留警卫标记字符。
+type Lab struct {
+	Map   *grid.Grid[rune]
+	Guard grid.Point
+	Dir   int
+}
+
+// Parse 从 r 读取实验室地图并找出警卫。
+func Parse(r io.Reader) (*Lab, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	g, err := grid.ParseRunes(text)
+	if err != nil {
+		return nil, err
+	}
+
+	guardPos, ok := g.Find(func(r rune) bool { return strings.ContainsRune(guardMarkers, r) })
+	if !ok {
+		return nil, errors.New("no guard found in map")
+	}
+	return &Lab{Map: g, Guard: guardPos, Dir: strings.IndexRune(guardMarkers, g.At(guardPos))}, nil
+}
@@ -0,0 +1,58 @@
+package day06
+
+import (
+	"image"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/render"
+)
+
+// Part1 返回警卫离开地图前访问过的不同位置数量。
+func Part1(input string) (int, error) {
+	lab, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findDistinctPositions(lab.Map, lab.Guard, lab.Dir, nil), nil
+}
+
+// findDistinctPositions 计算警卫访问的不同位置数量。anim 不为 nil 时记录警卫的每一步。
+func findDistinctPositions(g *grid.Grid[rune], startPos grid.Point, startDir int, anim *render.Animation) int {
+	// 使用map记录已访问的位置
+	visited := make(map[grid.Point]bool)
+
+	// 当前位置和方向
+	pos := startPos
+	dir := startDir
+
+	// 标记起始位置为已访问
+	visited[pos] = true
+
+	// 继续直到警卫离开地图区域
+	for {
+		// 确定前方位置
+		nextPos := pos.Add(grid.Dirs4[dir])
+
+		// 检查是否离开地图
+		nextCell, ok := g.Get(nextPos)
+		if !ok {
+			break
+		}
+
+		// 如果前方有障碍物，向右转
+		if nextCell == '#' {
+			dir = (dir + 1) % 4
+		} else {
+			// 否则，向前移动
+			pos = nextPos
+			visited[pos] = true
+			anim.Step(func() *image.Paletted { return labFrame(g, visited, pos) })
+		}
+	}
+	if anim != nil {
+		anim.Frame(labFrame(g, visited, pos))
+	}
+
+	return len(visited)
+}
@@ -0,0 +1,92 @@
+package day06
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part2 返回放置一个新障碍物即可让警卫陷入循环的位置数量。
+func Part2(input string) (int, error) {
+	lab, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return countObstaclePositions(lab.Map, lab.Guard, lab.Dir), nil
+}
+
+// checkLoop 检查警卫是否会进入循环
+func checkLoop(g *grid.Grid[rune], startPos grid.Point, startDir int, maxSteps int) bool {
+	// 记录警卫的状态 (位置+方向)
+	type State struct {
+		pos grid.Point
+		dir int
+	}
+	visited := make(map[State]int) // 状态 -> 步数
+
+	// 当前位置和方向
+	pos := startPos
+	dir := startDir
+	steps := 0
+
+	for steps < maxSteps {
+		// 记录当前状态
+		currentState := State{pos, dir}
+		if _, exists := visited[currentState]; exists {
+			// 找到循环
+			return true
+		}
+		visited[currentState] = steps
+
+		// 确定前方位置
+		nextPos := pos.Add(grid.Dirs4[dir])
+
+		// 检查是否离开地图
+		nextCell, ok := g.Get(nextPos)
+		if !ok {
+			return false // 离开地图，没有循环
+		}
+
+		// 如果前方有障碍物，向右转
+		if nextCell == '#' {
+			dir = (dir + 1) % 4
+		} else {
+			// 否则，向前移动
+			pos = nextPos
+		}
+
+		steps++
+	}
+
+	return false // 达到最大步数仍未找到循环
+}
+
+// countObstaclePositions 计算可以添加障碍物使警卫进入循环的位置数量
+func countObstaclePositions(g *grid.Grid[rune], guardPos grid.Point, guardDir int) int {
+	count := 0
+	maxSteps := g.Width * g.Height * 4 // 根据网格大小设置合理的步数限制
+
+	// 创建一个网格副本
+	copyGrid := g.Clone()
+
+	// 尝试在每个空位置添加障碍物
+	for p, cell := range g.All() {
+		// 跳过已有障碍物或警卫的位置
+		if cell == '#' || p == guardPos {
+			continue
+		}
+
+		// 添加障碍物
+		copyGrid.Set(p, '#')
+
+		// 检查是否会形成循环
+		if checkLoop(copyGrid, guardPos, guardDir, maxSteps) {
+			count++
+		}
+
+		// 恢复原始状态
+		copyGrid.Set(p, cell)
+	}
+
+	return count
+}
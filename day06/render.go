@@ -0,0 +1,37 @@
+package day06
+
+import (
+	"image"
+	"image/color"
+	"maps"
+	"slices"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/render"
+)
+
+// RenderPart1 与 Part1 相同，同时把警卫巡逻的每一步记录到 anim 中。
+func RenderPart1(input string, anim *render.Animation) (int, error) {
+	lab, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findDistinctPositions(lab.Map, lab.Guard, lab.Dir, anim), nil
+}
+
+// labStyle 把障碍物画成灰色，其余格子（包括警卫的初始标记）画成背景色。
+func labStyle(_ grid.Point, r rune) color.Color {
+	if r == '#' {
+		return render.Gray
+	}
+	return render.Black
+}
+
+// labFrame 画出实验室地图、警卫走过的位置以及警卫当前的位置。
+func labFrame(g *grid.Grid[rune], visited map[grid.Point]bool, guard grid.Point) *image.Paletted {
+	return render.Image(g, labStyle,
+		render.Overlay{Color: render.Blue, Points: maps.Keys(visited)},
+		render.Overlay{Color: render.Yellow, Points: slices.Values([]grid.Point{guard})},
+	)
+}
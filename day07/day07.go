@@ -0,0 +1,94 @@
+// Package day07 implements both parts of day 7 (Bridge Repair).
+package day07
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"adventofcode/parse"
+)
+
+// Equation represents a calibration equation
+type Equation struct {
+	testValue int
+	numbers   []int
+}
+
+// Parse reads the calibration equations from r, one "test: n1 n2 ..." per line.
+// Blank lines are ignored.
+func Parse(r io.Reader) ([]Equation, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var equations []Equation
+	for lineNo, line := range parse.Lines(text) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		colon := strings.IndexByte(line, ':')
+		if colon < 0 {
+			return nil, parse.Errorf(lineNo, 0, "invalid line format %q, want \"test: numbers\"", line)
+		}
+		if len(parse.Fields(line[:colon], nil)) != 1 {
+			return nil, parse.Errorf(lineNo, 1, "want a single test value before ':'")
+		}
+		nums, err := parse.Ints(lineNo, line, func(r rune) bool { return r == ':' || unicode.IsSpace(r) })
+		if err != nil {
+			return nil, err
+		}
+		testValue, numbers := nums[0], nums[1:]
+
+		equations = append(equations, Equation{
+			testValue: testValue,
+			numbers:   numbers,
+		})
+	}
+	return equations, nil
+}
+
+// evaluateExpression evaluates an expression with the given numbers and operators
+func evaluateExpression(numbers []int, operators []string) int {
+	// Create a copy of the numbers to work with
+	nums := make([]int, len(numbers))
+	copy(nums, numbers)
+
+	// Process operators from left to right
+	for i := 0; i < len(operators); i++ {
+		// Apply the operator between nums[0] and nums[1]
+		if operators[i] == "+" {
+			nums[0] += nums[1]
+		} else if operators[i] == "*" {
+			nums[0] *= nums[1]
+		} else if operators[i] == "||" {
+			// Concatenation operator
+			// Convert both numbers to strings, concatenate, then convert back to int
+			numStr1 := strconv.Itoa(nums[0])
+			numStr2 := strconv.Itoa(nums[1])
+			concatenated, _ := strconv.Atoi(numStr1 + numStr2)
+			nums[0] = concatenated
+		}
+
+		// Shift the remaining numbers left
+		for j := 1; j < len(nums)-1; j++ {
+			nums[j] = nums[j+1]
+		}
+	}
+
+	return nums[0]
+}
+
+// totalCalibration sums the test values of the equations that canSolve accepts
+func totalCalibration(equations []Equation, canSolve func(Equation) bool) int {
+	total := 0
+	for _, eq := range equations {
+		if canSolve(eq) {
+			total += eq.testValue
+		}
+	}
+	return total
+}
@@ -0,0 +1,44 @@
+package day07
+
+import "strings"
+
+// Part1 returns the total calibration result of the equations that can be made
+// true with + and * operators.
+func Part1(input string) (int, error) {
+	equations, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return totalCalibration(equations, canSolveEquation), nil
+}
+
+// canSolveEquation checks if the equation can be solved with any combination of + and * operators
+func canSolveEquation(eq Equation) bool {
+	// If there's only one number, check if it equals the test value
+	if len(eq.numbers) == 1 {
+		return eq.numbers[0] == eq.testValue
+	}
+
+	// Generate all possible combinations of operators
+	numOperators := len(eq.numbers) - 1
+	maxCombinations := 1 << numOperators // 2^numOperators
+
+	for i := 0; i < maxCombinations; i++ {
+		operators := make([]string, numOperators)
+		for j := 0; j < numOperators; j++ {
+			if (i & (1 << j)) == 0 {
+				operators[j] = "+"
+			} else {
+				operators[j] = "*"
+			}
+		}
+
+		// Evaluate the expression with this combination of operators
+		result := evaluateExpression(eq.numbers, operators)
+		if result == eq.testValue {
+			return true
+		}
+	}
+
+	return false
+}
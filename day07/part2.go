@@ -0,0 +1,48 @@
+package day07
+
+import "strings"
+
+// Part2 returns the total calibration result when the || concatenation
+// operator is also available.
+func Part2(input string) (int, error) {
+	equations, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return totalCalibration(equations, canSolveEquationWithConcat), nil
+}
+
+// canSolveEquationWithConcat checks if the equation can be solved with any combination of +, *, and || operators
+func canSolveEquationWithConcat(eq Equation) bool {
+	// If there's only one number, check if it equals the test value
+	if len(eq.numbers) == 1 {
+		return eq.numbers[0] == eq.testValue
+	}
+
+	// Generate all possible combinations of operators
+	numOperators := len(eq.numbers) - 1
+	// Now we have 3 operators (+, *, ||), so we need 3^numOperators combinations
+
+	// Helper function to generate all possible operator combinations
+	var checkCombinations func(int, []string) bool
+	checkCombinations = func(pos int, ops []string) bool {
+		if pos == numOperators {
+			// We have a complete set of operators, evaluate the expression
+			result := evaluateExpression(eq.numbers, ops)
+			return result == eq.testValue
+		}
+
+		// Try each operator at the current position
+		for _, op := range []string{"+", "*", "||"} {
+			ops[pos] = op
+			if checkCombinations(pos+1, ops) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	operators := make([]string, numOperators)
+	return checkCombinations(0, operators)
+}
@@ -0,0 +1,50 @@
+// Package day08 实现第 8 天（Resonant Collinearity）的两部分求解。
+package day08
+
+import (
+	"io"
+
+	"adventofcode/grid"
+	"adventofcode/parse"
+)
+
+// Antenna 表示一个天线及其频率和位置
+type Antenna struct {
+	frequency rune
+	position  grid.Point
+}
+
+// City 是解析后的谜题输入：城市地图和其中的天线。
+type City struct {
+	Map      *grid.Grid[rune]
+	Antennas []Antenna
+}
+
+// Parse 从 r 读取城市地图并列出所有天线（'.' 以外的字符）。
+func Parse(r io.Reader) (*City, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	g, err := grid.ParseRunes(text)
+	if err != nil {
+		return nil, err
+	}
+
+	city := &City{Map: g}
+	for p, char := range g.All() {
+		if char != '.' {
+			city.Antennas = append(city.Antennas, Antenna{frequency: char, position: p})
+		}
+	}
+	return city, nil
+}
+
+// groupByFrequency 按频率对天线进行分组
+func groupByFrequency(antennas []Antenna) map[rune][]Antenna {
+	frequencyGroups := make(map[rune][]Antenna)
+	for _, antenna := range antennas {
+		frequencyGroups[antenna.frequency] = append(frequencyGroups[antenna.frequency], antenna)
+	}
+	return frequencyGroups
+}
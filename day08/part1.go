@@ -0,0 +1,50 @@
+package day08
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part1 返回地图边界内包含反节点的唯一位置数量。
+func Part1(input string) (int, error) {
+	city, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findAntinodes(city.Map, city.Antennas), nil
+}
+
+// findAntinodes 计算所有反节点位置并返回唯一位置的数量
+func findAntinodes(g *grid.Grid[rune], antennas []Antenna) int {
+	// 使用map跟踪唯一的反节点位置
+	antinodes := make(map[grid.Point]bool)
+
+	// 对于每个频率组，找出所有对并计算反节点
+	for _, antennaGroup := range groupByFrequency(antennas) {
+		// 需要至少2个相同频率的天线才能形成反节点
+		for i := 0; i < len(antennaGroup); i++ {
+			for j := i + 1; j < len(antennaGroup); j++ {
+				a1 := antennaGroup[i].position
+				a2 := antennaGroup[j].position
+
+				// 计算两个反节点位置
+				// 第一个反节点：a1距离是a2距离的两倍
+				antinode1 := a2.Add(a2.Sub(a1))
+
+				// 第二个反节点：a2距离是a1距离的两倍
+				antinode2 := a1.Add(a1.Sub(a2))
+
+				// 检查反节点是否在网格边界内
+				if g.InBounds(antinode1) {
+					antinodes[antinode1] = true
+				}
+				if g.InBounds(antinode2) {
+					antinodes[antinode2] = true
+				}
+			}
+		}
+	}
+
+	return len(antinodes)
+}
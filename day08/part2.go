@@ -0,0 +1,100 @@
+package day08
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part2 返回考虑谐振效应后地图边界内包含反节点的唯一位置数量。
+func Part2(input string) (int, error) {
+	city, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findResonantAntinodes(city.Map, city.Antennas), nil
+}
+
+// 计算最大公约数
+func gcd(a, b int) int {
+	a, b = abs(a), abs(b)
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// 将向量简化为最简形式
+func simplifyVector(d grid.Point) grid.Point {
+	if d.Row == 0 && d.Col == 0 {
+		return d
+	}
+	if d.Row == 0 {
+		return grid.Point{Row: 0, Col: d.Col / abs(d.Col)}
+	}
+	if d.Col == 0 {
+		return grid.Point{Row: d.Row / abs(d.Row), Col: 0}
+	}
+
+	g := gcd(d.Row, d.Col)
+	return grid.Point{Row: d.Row / g, Col: d.Col / g}
+}
+
+// 绝对值函数
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// findResonantAntinodes 计算所有反节点位置并返回唯一位置的数量
+func findResonantAntinodes(g *grid.Grid[rune], antennas []Antenna) int {
+	// 使用map跟踪唯一的反节点位置
+	antinodes := make(map[grid.Point]bool)
+
+	// 对于每个频率组，找出所有成一直线的点
+	for _, antennaGroup := range groupByFrequency(antennas) {
+		// 需要至少2个相同频率的天线才能形成反节点
+		if len(antennaGroup) < 2 {
+			continue
+		}
+
+		// 对于每对天线，找出它们之间和延长线上的所有点
+		for i := 0; i < len(antennaGroup); i++ {
+			for j := i + 1; j < len(antennaGroup); j++ {
+				a1 := antennaGroup[i].position
+				a2 := antennaGroup[j].position
+
+				// 计算方向向量并简化
+				step := simplifyVector(a2.Sub(a1))
+
+				// 从a1开始，沿着方向向量移动，直到到达a2
+				// 所有这些点都是反节点
+				for pos := a1; ; pos = pos.Add(step) {
+					if pos == a2 {
+						antinodes[pos] = true
+						break
+					}
+
+					if g.InBounds(pos) {
+						antinodes[pos] = true
+					}
+				}
+
+				// 继续沿着方向向量移动，直到离开网格
+				// 向a2方向移动
+				for pos := a2.Add(step); g.InBounds(pos); pos = pos.Add(step) {
+					antinodes[pos] = true
+				}
+
+				// 向a1反方向移动
+				for pos := a1.Sub(step); g.InBounds(pos); pos = pos.Sub(step) {
+					antinodes[pos] = true
+				}
+			}
+		}
+	}
+
+	return len(antinodes)
+}
@@ -0,0 +1,77 @@
+// Package day09 implements both parts of day 9 (Disk Fragmenter).
+package day09
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"unicode"
+
+	"adventofcode/parse"
+)
+
+type File struct {
+	ID   int
+	Size int
+	Pos  int // Starting position on disk
+}
+
+// FileRange represents a range of blocks belonging to a file
+type FileRange struct {
+	ID       int
+	StartPos int
+	Size     int
+}
+
+// FreeRange represents a range of free space blocks
+type FreeRange struct {
+	StartPos int
+	Size     int
+}
+
+// Parse reads the disk map from r: a single line of digits.
+func Parse(r io.Reader) ([]int, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var diskMap []int
+	for lineNo, line := range parse.Lines(text) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if diskMap != nil {
+			return nil, parse.Errorf(lineNo, 0, "unexpected second line, the disk map is a single line")
+		}
+		diskMap = make([]int, 0, len(line))
+		for i, char := range line {
+			if char < '0' || char > '9' {
+				if unicode.IsSpace(char) && strings.TrimSpace(line[i:]) == "" {
+					break // 行尾空白
+				}
+				return nil, parse.Errorf(lineNo, parse.Col(line, i), "invalid character %q, want a digit", char)
+			}
+			diskMap = append(diskMap, int(char-'0'))
+		}
+	}
+
+	if len(diskMap) == 0 {
+		return nil, errors.New("empty input")
+	}
+	return diskMap, nil
+}
+
+// calculateChecksum computes the checksum based on file positions
+func calculateChecksum(expandedDisk []int) int {
+	checksum := 0
+
+	// Calculate checksum: sum of (position * fileID) for each block
+	for pos, fileID := range expandedDisk {
+		if fileID != -1 { // Skip free space
+			checksum += pos * fileID
+		}
+	}
+
+	return checksum
+}
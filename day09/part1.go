@@ -0,0 +1,109 @@
+package day09
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Part1 returns the filesystem checksum after moving single blocks into the
+// leftmost free space.
+func Part1(input string) (int, error) {
+	diskMap, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+
+	compactedDisk, err := compactDisk(diskMap)
+	if err != nil {
+		return 0, err
+	}
+
+	return calculateChecksum(compactedDisk), nil
+}
+
+// createExpandedDisk creates an expanded representation of the disk
+// where each element represents a single block
+func createExpandedDisk(diskMap []int) []int {
+	// Estimate total disk size to pre-allocate memory
+	totalSize := 0
+	for _, size := range diskMap {
+		totalSize += size
+	}
+
+	expandedDisk := make([]int, 0, totalSize) // Pre-allocate memory
+
+	isFile := true
+	fileID := 0
+
+	for _, size := range diskMap {
+		for i := 0; i < size; i++ {
+			if isFile {
+				expandedDisk = append(expandedDisk, fileID)
+			} else {
+				expandedDisk = append(expandedDisk, -1) // -1 represents free space
+			}
+		}
+		if isFile {
+			fileID++
+		}
+		isFile = !isFile
+	}
+
+	return expandedDisk
+}
+
+// compactExpandedDisk performs the compaction process on the expanded disk
+// by moving file blocks from right to left
+func compactExpandedDisk(expandedDisk []int) []int {
+	// Create a copy of the expanded disk to avoid modifying the original
+	compactedDisk := make([]int, len(expandedDisk))
+	copy(compactedDisk, expandedDisk)
+
+	// Track free space positions for optimization
+	var freeSpaces []int
+	for i, block := range compactedDisk {
+		if block == -1 {
+			freeSpaces = append(freeSpaces, i)
+		}
+	}
+
+	// Process each free space from left to right
+	for _, freePos := range freeSpaces {
+		// Skip if this position is no longer free (already filled by a previous move)
+		if compactedDisk[freePos] != -1 {
+			continue
+		}
+
+		// Find the rightmost file block
+		rightmostFilePos := -1
+		for j := len(compactedDisk) - 1; j > freePos; j-- {
+			if compactedDisk[j] != -1 {
+				rightmostFilePos = j
+				break
+			}
+		}
+
+		if rightmostFilePos != -1 {
+			// Move the file block to the free space
+			compactedDisk[freePos] = compactedDisk[rightmostFilePos]
+			compactedDisk[rightmostFilePos] = -1
+		} else {
+			// No more file blocks to move
+			break
+		}
+	}
+
+	return compactedDisk
+}
+
+// compactDisk is a wrapper function that handles the entire compaction process
+func compactDisk(diskMap []int) ([]int, error) {
+	if len(diskMap) == 0 {
+		return nil, fmt.Errorf("empty disk map")
+	}
+
+	expandedDisk := createExpandedDisk(diskMap)
+	compactedDisk := compactExpandedDisk(expandedDisk)
+
+	return compactedDisk, nil
+}
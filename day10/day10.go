@@ -0,0 +1,24 @@
+// Package day10 implements both parts of day 10 (Hoof It).
+package day10
+
+import (
+	"io"
+
+	"adventofcode/grid"
+	"adventofcode/parse"
+)
+
+// Parse reads the topographic map from r into a grid of heights.
+// Impassable tiles ('.') get height -1.
+func Parse(r io.Reader) (*grid.Grid[int], error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	return grid.ParseDigits(text)
+}
+
+// trailheads returns all positions with height 0
+func trailheads(g *grid.Grid[int]) []grid.Point {
+	return grid.LocateAll(g, 0)
+}
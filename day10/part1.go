@@ -0,0 +1,60 @@
+package day10
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part1 returns the sum of the scores of all trailheads.
+func Part1(input string) (int, error) {
+	g, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return calculateTotalScore(g), nil
+}
+
+// findTrailheadScore calculates the score for a single trailhead
+func findTrailheadScore(g *grid.Grid[int], start grid.Point) int {
+	// Set to track unique 9s we've reached
+	reachableNines := make(map[grid.Point]bool)
+
+	// Use BFS to find all paths. A position's height is fixed, so the
+	// position alone identifies a search state.
+	queue := []grid.Point{start}
+	visited := map[grid.Point]bool{start: true}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		height := g.At(current)
+
+		// If we've reached a 9, mark it as reachable
+		if height == 9 {
+			reachableNines[current] = true
+			continue
+		}
+
+		// Try all four directions
+		for next := range g.Neighbors4(current) {
+			// We can only move to positions with height exactly one more than current
+			if g.At(next) == height+1 && !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	// Return the number of unique 9s reached
+	return len(reachableNines)
+}
+
+// calculateTotalScore calculates the sum of scores for all trailheads
+func calculateTotalScore(g *grid.Grid[int]) int {
+	totalScore := 0
+	for _, start := range trailheads(g) {
+		totalScore += findTrailheadScore(g, start)
+	}
+	return totalScore
+}
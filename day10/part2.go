@@ -0,0 +1,65 @@
+package day10
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part2 returns the sum of the ratings of all trailheads.
+func Part2(input string) (int, error) {
+	g, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return calculateTotalRating(g), nil
+}
+
+// countDistinctTrails calculates the number of distinct hiking trails from a trailhead
+func countDistinctTrails(g *grid.Grid[int], start grid.Point) int {
+	// Create a memoization cache
+	memo := make(map[grid.Point]int)
+
+	// Define a recursive function to count paths
+	var countPaths func(pos grid.Point) int
+	countPaths = func(pos grid.Point) int {
+		height := g.At(pos)
+
+		// If we've reached height 9, we've found a complete trail
+		if height == 9 {
+			return 1
+		}
+
+		// Check if we've already computed this
+		if count, exists := memo[pos]; exists {
+			return count
+		}
+
+		// Count paths from this position
+		count := 0
+
+		// Try all four directions
+		for next := range g.Neighbors4(pos) {
+			// We can only move to positions with height exactly one more than current
+			if g.At(next) == height+1 {
+				count += countPaths(next)
+			}
+		}
+
+		// Store result in memo
+		memo[pos] = count
+		return count
+	}
+
+	// Start counting from the trailhead
+	return countPaths(start)
+}
+
+// calculateTotalRating calculates the sum of ratings for all trailheads
+func calculateTotalRating(g *grid.Grid[int]) int {
+	totalRating := 0
+	for _, start := range trailheads(g) {
+		totalRating += countDistinctTrails(g, start)
+	}
+	return totalRating
+}
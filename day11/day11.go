@@ -0,0 +1,25 @@
+// Package day11 implements both parts of day 11 (Plutonian Pebbles).
+package day11
+
+import (
+	"io"
+
+	"adventofcode/parse"
+)
+
+// Parse reads the initial stone arrangement from r: whitespace-separated numbers.
+func Parse(r io.Reader) ([]int, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	var stones []int
+	for lineNo, line := range parse.Lines(text) {
+		nums, err := parse.Ints(lineNo, line, nil)
+		if err != nil {
+			return nil, err
+		}
+		stones = append(stones, nums...)
+	}
+	return stones, nil
+}
@@ -0,0 +1,57 @@
+package day11
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Part1 returns the number of stones after 25 blinks.
+func Part1(input string) (int, error) {
+	stones, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < 25; i++ {
+		stones = simulateBlink(stones)
+	}
+	return len(stones), nil
+}
+
+// applyRules applies the transformation rules to a single stone
+func applyRules(stone int) []int {
+	// Convert to string to work with digits
+	stoneStr := strconv.Itoa(stone)
+
+	// Rule 1: If the stone is 0, replace with 1
+	if stone == 0 {
+		return []int{1}
+	}
+
+	// Rule 2: If the stone has an even number of digits, split it
+	if len(stoneStr)%2 == 0 {
+		midpoint := len(stoneStr) / 2
+		leftHalf := stoneStr[:midpoint]
+		rightHalf := stoneStr[midpoint:]
+
+		leftNum, _ := strconv.Atoi(leftHalf)
+		rightNum, _ := strconv.Atoi(rightHalf)
+
+		return []int{leftNum, rightNum}
+	}
+
+	// Rule 3: Multiply by 2024
+	return []int{stone * 2024}
+}
+
+// simulateBlink simulates one blink transformation on all stones
+func simulateBlink(stones []int) []int {
+	var newStones []int
+
+	for _, stone := range stones {
+		transformedStones := applyRules(stone)
+		newStones = append(newStones, transformedStones...)
+	}
+
+	return newStones
+}
@@ -0,0 +1,102 @@
+package day11
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Part2 返回 75 次 blink 之后的石头数量。
+func Part2(input string) (int, error) {
+	stones, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+
+	bag := newBag(stones)
+	for i := 0; i < 75; i++ {
+		bag = simulateBlinkMap(bag, false)
+	}
+	return totalCount(bag), nil
+}
+
+// Bag 统计每种石头值出现的次数
+type Bag map[int]int
+
+// newBag 把石头列表转换为按值计数的 Bag
+func newBag(stones []int) Bag {
+	bag := make(Bag)
+	for _, stone := range stones {
+		bag[stone]++
+	}
+	return bag
+}
+
+// countDigits 返回一个非负整数的位数
+func countDigits(n int) int {
+	if n == 0 {
+		return 1
+	}
+	count := 0
+	for n > 0 {
+		n /= 10
+		count++
+	}
+	return count
+}
+
+// 缓存规则结果以避免重复转换
+var ruleCache = make(map[int][]int)
+
+// applyRulesCached 应用规则并缓存转换结果
+func applyRulesCached(stone int) []int {
+	if cached, ok := ruleCache[stone]; ok {
+		return cached
+	}
+	var result []int
+	if stone == 0 {
+		result = []int{1}
+	} else {
+		numDigits := countDigits(stone)
+		if numDigits%2 == 0 {
+			divisor := 1
+			for i := 0; i < numDigits/2; i++ {
+				divisor *= 10
+			}
+			left := stone / divisor
+			right := stone % divisor
+			result = []int{left, right}
+		} else {
+			result = []int{stone * 2024}
+		}
+	}
+	ruleCache[stone] = result
+	return result
+}
+
+// simulateBlinkMap 模拟一次 blink，返回新的 Bag，支持 trace 打印
+func simulateBlinkMap(bag Bag, trace bool) Bag {
+	newBag := make(Bag)
+	for stone, count := range bag {
+		newStones := applyRulesCached(stone)
+		if trace {
+			fmt.Printf("  %d x %d → ", stone, count)
+			for _, s := range newStones {
+				fmt.Printf("%d x %d ", s, count)
+			}
+			fmt.Println()
+		}
+		for _, s := range newStones {
+			newBag[s] += count
+		}
+	}
+	return newBag
+}
+
+// totalCount 返回 Bag 中石头总数量
+func totalCount(bag Bag) int {
+	sum := 0
+	for _, count := range bag {
+		sum += count
+	}
+	return sum
+}
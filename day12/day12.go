@@ -0,0 +1,25 @@
+// Package day12 实现第 12 天（Garden Groups）的两部分求解。
+package day12
+
+import (
+	"io"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/parse"
+)
+
+// Parse 从 r 读取地图并转换为字符网格。
+// 它会处理输入块周围和每行首尾可能存在的空白字符；各行长度不一致时返回错误。
+func Parse(r io.Reader) (*grid.Grid[rune], error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	// 逐行去掉空白但保留行数，这样网格报告的行号与原始输入一致。
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return grid.ParseRunes(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,63 @@
+package day12
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part1 返回所有区域按面积乘周长计算的总价格。
+func Part1(input string) (int, error) {
+	g, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findRegionsAndCalculateTotalPrice(g), nil
+}
+
+// findRegionsAndCalculateTotalPrice 处理网格以找到所有区域，
+// 并返回它们价格的总和。
+func findRegionsAndCalculateTotalPrice(g *grid.Grid[rune]) int {
+	visited := grid.New[bool](g.Width, g.Height)
+	totalPrice := 0
+
+	for start, currentPlantType := range g.All() {
+		if visited.At(start) {
+			continue
+		}
+
+		// 开始对新区域进行 BFS
+		currentArea := 0
+		currentPerimeter := 0
+
+		q := []grid.Point{start} // BFS 队列
+		visited.Set(start, true)
+
+		head := 0 // BFS 队列的头部指针
+		for head < len(q) {
+			curr := q[head]
+			head++
+
+			currentArea++
+
+			// 检查4个邻居以计算周长和确定区域中的下一个单元格
+			for _, d := range grid.Dirs4 {
+				next := curr.Add(d)
+
+				if plant, ok := g.Get(next); !ok || plant != currentPlantType {
+					// 邻居超出边界或是不同类型的植物，对周长有贡献
+					currentPerimeter++
+				} else if !visited.At(next) {
+					// 邻居是相同类型的植物
+					visited.Set(next, true)
+					q = append(q, next)
+				}
+			}
+		}
+
+		// 区域已找到，计算其价格
+		totalPrice += currentArea * currentPerimeter
+	}
+
+	return totalPrice
+}
@@ -0,0 +1,200 @@
+package day12
+
+import (
+	"sort"
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part2 返回所有区域按面积乘边数计算的批量折扣总价格。
+func Part2(input string) (int, error) {
+	g, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findRegionsAndCalculateDiscountPrice(g), nil
+}
+
+type Interval struct {
+	Start, End int
+}
+
+// findRegionsAndCalculateDiscountPrice 在扩展网格上找出所有区域，
+// 并返回按面积乘边数计算的价格总和。
+func findRegionsAndCalculateDiscountPrice(g *grid.Grid[rune]) int {
+	if g.Width == 0 || g.Height == 0 {
+		return 0
+	}
+	numRows := g.Height
+	numCols := g.Width
+	if numRows == 1 && numCols == 1 { // 1x1 网格
+		return 1 * 4 // 1个面积，4条边
+	}
+
+	// 定义新的扩展网格尺寸
+	// expandedGrid[2r][2c] 存储原始单元格类型
+	// expandedGrid[2r+1][2c] 存储垂直栅栏状态
+	// expandedGrid[2r][2c+1] 存储水平栅栏状态
+	// expandedGrid[2r+1][2c+1] 存储对角线交点栅栏状态
+	// 单行或单列网格没有中间行/列，2n-1 恰好为 1。
+	// 初始填充，0 表示空或分隔，其他表示植物类型
+	expGrid := grid.New[rune](numCols*2-1, numRows*2-1)
+	plant := func(r, c int) rune { return g.At(grid.Point{Row: r, Col: c}) }
+
+	// 填充原始植物类型
+	for p, t := range g.All() {
+		expGrid.Set(p.Scale(2), t)
+	}
+
+	// 处理水平和垂直连接
+	for r := 0; r < numRows; r++ {
+		for c := 0; c < numCols; c++ {
+			// 右侧连接
+			if c < numCols-1 {
+				p := grid.Point{Row: r * 2, Col: c*2 + 1}
+				if plant(r, c) == plant(r, c+1) {
+					expGrid.Set(p, plant(r, c)) // 如果同类型，则连接
+				} else {
+					expGrid.Set(p, ' ') // 否则为边界，用空格或其他非零但非植物字符表示
+				}
+			}
+			// 下方连接
+			if r < numRows-1 {
+				p := grid.Point{Row: r*2 + 1, Col: c * 2}
+				if plant(r, c) == plant(r+1, c) {
+					expGrid.Set(p, plant(r, c)) // 如果同类型，则连接
+				} else {
+					expGrid.Set(p, ' ') // 否则为边界
+				}
+			}
+		}
+	}
+
+	// 处理对角线交点
+	// expandedGrid[2r+1][2c+1] 对应原始网格的 (r,c), (r,c+1), (r+1,c), (r+1,c+1) 的中心
+	for r := 0; r < numRows-1; r++ {
+		for c := 0; c < numCols-1; c++ {
+			topLeft := plant(r, c)
+			topRight := plant(r, c+1)
+			bottomLeft := plant(r+1, c)
+			bottomRight := plant(r+1, c+1)
+			center := grid.Point{Row: r*2 + 1, Col: c*2 + 1}
+
+			// 根据题目说明：如果对角线上的单元格类型相同，但与另一对角线上的单元格类型不同，则栅栏不连接。
+			// 此时，中心点应为分隔，即使是相同类型的植物，也不能通过此点连接。
+			if topLeft == bottomRight && topRight == bottomLeft && topLeft != topRight {
+				expGrid.Set(center, ' ') // ' ' 表示不可通过，是栅栏
+			} else if topLeft == topRight && topLeft == bottomLeft && topLeft == bottomRight {
+				// 如果不是 X 型交界，且四角都是相同类型，那么中心可以连接
+				expGrid.Set(center, topLeft)
+			} else {
+				// 如果有混合类型，中心点也是分隔
+				expGrid.Set(center, ' ')
+			}
+		}
+	}
+
+	visited := grid.New[bool](expGrid.Width, expGrid.Height)
+	totalPrice := 0
+
+	for start, currentPlantType := range expGrid.All() {
+		// 只对原始单元格对应的点 (2r, 2c) 进行 BFS
+		if start.Row%2 != 0 || start.Col%2 != 0 || visited.At(start) || currentPlantType == ' ' || currentPlantType == 0 {
+			continue
+		}
+
+		currentArea := 0
+		regionHedges := make(map[int][]Interval) // y -> list of x-intervals
+		regionVedges := make(map[int][]Interval) // x -> list of y-intervals
+
+		q := []grid.Point{start}
+		visited.Set(start, true)
+		head := 0
+
+		for head < len(q) {
+			curr := q[head]
+			head++
+
+			// 如果当前是原始单元格的点 (2r, 2c)，则面积+1
+			if curr.Row%2 == 0 && curr.Col%2 == 0 {
+				currentArea++
+			}
+
+			// 只考虑垂直和水平移动
+			for _, d := range grid.Dirs4 {
+				next := curr.Add(d)
+				if t, ok := expGrid.Get(next); ok {
+					// 检查邻居类型是否相同且未访问
+					if t == currentPlantType {
+						if !visited.At(next) {
+							visited.Set(next, true)
+							q = append(q, next)
+						}
+						continue
+					}
+					// 只有当邻居是' ' (栅栏) 或不同类型的植物时，才算作边界
+					if t == 0 {
+						continue
+					}
+				}
+
+				// 记录边界段（超出边界也算作边界）
+				// 注意：边界是在 expGrid 中计算的
+				switch d {
+				case grid.Up: // 邻居在上方，即当前点的上边缘
+					regionHedges[curr.Row] = append(regionHedges[curr.Row], Interval{Start: curr.Col, End: curr.Col + 1})
+				case grid.Down: // 邻居在下方，即当前点的下边缘
+					regionHedges[curr.Row+1] = append(regionHedges[curr.Row+1], Interval{Start: curr.Col, End: curr.Col + 1})
+				case grid.Left: // 邻居在左侧，即当前点的左边缘
+					regionVedges[curr.Col] = append(regionVedges[curr.Col], Interval{Start: curr.Row, End: curr.Row + 1})
+				case grid.Right: // 邻居在右侧，即当前点的右边缘
+					regionVedges[curr.Col+1] = append(regionVedges[curr.Col+1], Interval{Start: curr.Row, End: curr.Row + 1})
+				}
+			}
+		}
+
+		numberOfSides := 0
+		for _, segments := range regionHedges {
+			numberOfSides += countAndMergeSegments(segments)
+		}
+		for _, segments := range regionVedges {
+			numberOfSides += countAndMergeSegments(segments)
+		}
+
+		totalPrice += currentArea * numberOfSides
+	}
+	return totalPrice
+}
+
+func countAndMergeSegments(segments []Interval) int {
+	if len(segments) == 0 {
+		return 0
+	}
+
+	// 按起点排序
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].Start < segments[j].Start
+	})
+
+	// 合并相邻或重叠的段
+	mergedCount := 1 // 计入第一个段
+	currentEnd := segments[0].End
+
+	for i := 1; i < len(segments); i++ {
+		current := segments[i]
+
+		if current.Start <= currentEnd {
+			// 合并段
+			if current.End > currentEnd {
+				currentEnd = current.End
+			}
+		} else {
+			// 添加新段
+			mergedCount++
+			currentEnd = current.End
+		}
+	}
+
+	return mergedCount
+}
@@ -0,0 +1,112 @@
+package day12
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string // grid.String() 的输出
+		wantErr bool
+	}{
+		{
+			name:  "empty string",
+			input: "",
+			want:  "",
+		},
+		{
+			name:  "only newlines and spaces",
+			input: "\n  \n ",
+			want:  "",
+		},
+		{
+			name:  "simple input",
+			input: "A",
+			want:  "A\n",
+		},
+		{
+			name:  "multiline input",
+			input: "AB\nCD",
+			want:  "AB\nCD\n",
+		},
+		{
+			name:  "input with leading/trailing spaces on lines",
+			input: "  AB  \n  CD  ",
+			want:  "AB\nCD\n",
+		},
+		{
+			name:  "input with leading/trailing spaces around block",
+			input: "  \n  AB\nCD  \n  ",
+			want:  "AB\nCD\n",
+		},
+		{
+			name:    "input with empty lines in between",
+			input:   "AB\n\nCD", // 网格必须是矩形，中间的空行会导致行长度不一致
+			wantErr: true,
+		},
+		{
+			name:  "single line with spaces",
+			input: " A B ",
+			want:  "A B\n", // strings.TrimSpace(line)
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Parse() got = %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFindRegionsAndCalculateTotalPrice(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name:  "Simple example1",
+			input: "EEEEE\nEXXXX\nEEEEE\nEXXXX\nEEEEE",
+			want:  236, // As per the problem description
+		},
+		{
+			name:  "Simple example2",
+			input: "AAAA\nBBCD\nBBCC\nEEEC",
+			want:  80,
+		},
+		{
+			name:  "Simple example3",
+			input: "AAAAAA\nAAABBA\nAAABBA\nABBAAA\nABBAAA\nAAAAAA",
+			want:  368,
+		},
+
+		{
+			name:  "Simple example4",
+			input: "RRRRIICCFF\nRRRRIICCCF\nVVRRRCCFFF\nVVRCCCJFFF\nVVVVCJJCFE\nVVIVCCJJEE\nVVIIICJJEE\nMIIIIIJJEE\nMIIISIJEEE\nMMMISSJEEE",
+			want:  1206,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := findRegionsAndCalculateDiscountPrice(g); got != tt.want {
+				t.Errorf("findRegionsAndCalculateDiscountPrice() for grid\n%v = %v, want %v", g, got, tt.want)
+			}
+		})
+	}
+}
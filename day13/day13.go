@@ -0,0 +1,61 @@
+// Package day13 implements both parts of day 13 (Claw Contraption).
+package day13
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// machineLines are the three lines that describe one claw machine, in order.
+var machineLines = [3]struct {
+	prefix string
+	re     *regexp.Regexp
+}{
+	{"Button A:", regexp.MustCompile(`^Button A: X\+(\d+), Y\+(\d+)$`)},
+	{"Button B:", regexp.MustCompile(`^Button B: X\+(\d+), Y\+(\d+)$`)},
+	{"Prize:", regexp.MustCompile(`^Prize: X=(\d+), Y=(\d+)$`)},
+}
+
+// Parse reads the claw machines from r. Machines are separated by blank lines;
+// each has a "Button A", a "Button B" and a "Prize" line.
+func Parse(r io.Reader) ([]ClawMachine, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var machines []ClawMachine
+	for start, block := range parse.Blocks(text) {
+		lines := strings.Split(block, "\n")
+		if len(lines) != len(machineLines) {
+			return nil, parse.Errorf(start, 0, "machine has %d lines, want %d", len(lines), len(machineLines))
+		}
+
+		var values [6]int
+		for i, line := range lines {
+			line = strings.TrimSpace(line)
+			want := machineLines[i]
+			m := want.re.FindStringSubmatch(line)
+			if m == nil {
+				if !strings.HasPrefix(line, want.prefix) {
+					return nil, parse.Errorf(start+i, 1, "want a line starting with %q", want.prefix)
+				}
+				return nil, parse.Errorf(start+i, len(want.prefix)+1, "malformed %s line %q", strings.TrimSuffix(want.prefix, ":"), line)
+			}
+			values[2*i], _ = strconv.Atoi(m[1])
+			values[2*i+1], _ = strconv.Atoi(m[2])
+		}
+
+		// Costs are fixed for this problem: A=3, B=1
+		machines = append(machines, ClawMachine{
+			MoveAX: values[0], MoveAY: values[1], CostA: 3,
+			MoveBX: values[2], MoveBY: values[3], CostB: 1,
+			TargetX: values[4], TargetY: values[5],
+		})
+	}
+	return machines, nil
+}
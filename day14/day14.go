@@ -0,0 +1,58 @@
+// Package day14 实现第 14 天（Restroom Redoubt）的两部分求解。
+package day14
+
+import (
+	"io"
+	"strings"
+	"unicode"
+
+	"adventofcode/parse"
+)
+
+// 主问题所需的空间尺寸
+const (
+	Width  = 101
+	Height = 103
+)
+
+// Robot 结构体表示一个机器人的位置和速度
+type Robot struct {
+	Px int // 初始X坐标
+	Py int // 初始Y坐标
+	Vx int // X轴速度
+	Vy int // Y轴速度
+}
+
+// Parse 从 r 读取机器人列表，每行形如 "p=x,y v=dx,dy"；空行会被忽略。
+func Parse(r io.Reader) ([]Robot, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var robots []Robot
+	for lineNo, line := range parse.Lines(text) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		// "p=x,y v=x,y" 切分后应为 p x y v x y 六个字段
+		fields := parse.Fields(line, func(r rune) bool { return r == '=' || r == ',' || unicode.IsSpace(r) })
+		if len(fields) != 6 || fields[0].Text != "p" || fields[3].Text != "v" {
+			return nil, parse.Errorf(lineNo, 0, "格式错误的行 %q，应为 \"p=x,y v=x,y\"", line)
+		}
+		var nums [4]int
+		for i, f := range []parse.Field{fields[1], fields[2], fields[4], fields[5]} {
+			if nums[i], err = f.Int(lineNo); err != nil {
+				return nil, err
+			}
+		}
+		robots = append(robots, Robot{Px: nums[0], Py: nums[1], Vx: nums[2], Vy: nums[3]})
+	}
+	return robots, nil
+}
+
+// mod 函数用于正确处理 Go 语言中负数的取模运算。
+// Go 的 % 运算符在被除数为负时可能返回负结果。
+func mod(a, n int) int {
+	return (a%n + n) % n
+}
@@ -0,0 +1,61 @@
+package day14
+
+import "strings"
+
+// Part1 返回 100 秒后的安全系数。
+func Part1(input string) (int, error) {
+	robots, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return CalculateSafetyFactor(robots, Width, Height, 100), nil
+}
+
+// CalculateSafetyFactor 函数模拟机器人移动并计算安全系数。
+func CalculateSafetyFactor(robots []Robot, width, height, simulationTime int) int {
+	// 使用 map 统计每个最终位置的机器人数量
+	finalPositions := make(map[[2]int]int) // [2]int 用作 x, y 坐标的键
+
+	for _, robot := range robots {
+		// 计算机器人经过 simulationTime 秒后的最终位置，并应用环绕效果
+		finalX := mod(robot.Px+robot.Vx*simulationTime, width)
+		finalY := mod(robot.Py+robot.Vy*simulationTime, height)
+		// 增加该位置的机器人计数
+		finalPositions[[2]int{finalX, finalY}]++
+	}
+
+	// 计算空间中线的位置
+	midX := width / 2
+	midY := height / 2
+
+	// 初始化四个象限的机器人计数：[左上, 右上, 左下, 右下]
+	quadrantCounts := [4]int{0, 0, 0, 0}
+
+	for pos, count := range finalPositions {
+		x, y := pos[0], pos[1]
+
+		// 机器人如果正好在中间线上，则不计入任何象限
+		if x == midX || y == midY {
+			continue
+		}
+
+		// 判断象限并增加计数
+		if x < midX && y < midY { // 左上象限
+			quadrantCounts[0] += count
+		} else if x > midX && y < midY { // 右上象限
+			quadrantCounts[1] += count
+		} else if x < midX && y > midY { // 左下象限
+			quadrantCounts[2] += count
+		} else if x > midX && y > midY { // 右下象限
+			quadrantCounts[3] += count
+		}
+	}
+
+	// 计算安全系数（四个象限计数的乘积）
+	safetyFactor := 1
+	for _, count := range quadrantCounts {
+		safetyFactor *= count
+	}
+
+	return safetyFactor
+}
@@ -1,6 +1,7 @@
-package main
+package day14
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -42,7 +43,7 @@ p=9,5 v=-3,-3`
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 解析输入数据
-			robots, err := ParseInput(tt.input)
+			robots, err := Parse(strings.NewReader(tt.input))
 			if err != nil {
 				t.Fatalf("解析输入失败: %v", err)
 			}
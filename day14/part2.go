@@ -0,0 +1,97 @@
+package day14
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Part2 返回机器人第一次排列出圣诞树图案的时间。
+func Part2(input string) (int, error) {
+	robots, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return FindChristmasTreeTime(robots, Width, Height)
+}
+
+// FindChristmasTreeTime 分别找出 X 轴和 Y 轴上机器人最聚集的时间点，
+// 再用中国剩余定理合成圣诞树图案出现的时间。
+func FindChristmasTreeTime(robots []Robot, width, height int) (int, error) {
+	xPositions := make([]int, len(robots))
+	xVelocities := make([]int, len(robots))
+	yPositions := make([]int, len(robots))
+	yVelocities := make([]int, len(robots))
+	for i, robot := range robots {
+		xPositions[i], xVelocities[i] = robot.Px, robot.Vx
+		yPositions[i], yVelocities[i] = robot.Py, robot.Vy
+	}
+
+	mostClusteredXIteration := mostClusteredIteration(xPositions, xVelocities, width)
+	mostClusteredYIteration := mostClusteredIteration(yPositions, yVelocities, height)
+
+	// 使用中国剩余定理合成最终时间
+	invMod, err := modInverse(width, height)
+	if err != nil {
+		return 0, fmt.Errorf("无法计算模逆元: %w", err)
+	}
+
+	diff := mostClusteredYIteration - mostClusteredXIteration
+	k := mod(diff*invMod, height)
+
+	return mostClusteredXIteration + k*width, nil
+}
+
+// mostClusteredIteration 在一个周期内模拟单个轴的移动，
+// 返回同一坐标上机器人最多的时间点。positions 会被原地修改。
+func mostClusteredIteration(positions, velocities []int, size int) int {
+	mostClustered := 0
+	biggestCluster := 0
+
+	counts := make([]int, size)
+	for t := 0; t < size; t++ {
+		clear(counts)
+		for _, pos := range positions {
+			counts[pos]++
+		}
+
+		maxCount := 0
+		for _, count := range counts {
+			if count > maxCount {
+				maxCount = count
+			}
+		}
+
+		if maxCount > biggestCluster {
+			biggestCluster = maxCount
+			mostClustered = t
+		}
+
+		for j := range positions {
+			positions[j] = mod(positions[j]+velocities[j], size)
+		}
+	}
+
+	return mostClustered
+}
+
+// --- 数学工具：用于中国剩余定理 ---
+
+// extendedGCD 实现了扩展欧几里得算法，计算 ax + by = gcd(a,b)。
+func extendedGCD(a, b int) (int, int, int) {
+	if a == 0 {
+		return b, 0, 1
+	}
+	gcd, x1, y1 := extendedGCD(b%a, a)
+	x := y1 - (b/a)*x1
+	y := x1
+	return gcd, x, y
+}
+
+// modInverse 计算 a 的模 m 逆元，即 (a * x) % m = 1。
+func modInverse(a, m int) (int, error) {
+	gcd, x, _ := extendedGCD(a, m)
+	if gcd != 1 {
+		return 0, fmt.Errorf("%d has no modular inverse modulo %d", a, m)
+	}
+	return (x%m + m) % m, nil
+}
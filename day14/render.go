@@ -0,0 +1,44 @@
+package day14
+
+import (
+	"image"
+	"image/color"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/render"
+)
+
+// RenderPart2 与 Part2 相同，同时把机器人从第 0 秒到圣诞树出现那一秒的
+// 移动过程记录到 anim 中，最后一帧就是圣诞树。
+func RenderPart2(input string, anim *render.Animation) (int, error) {
+	robots, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	treeTime, err := FindChristmasTreeTime(robots, Width, Height)
+	if err != nil || anim == nil {
+		return treeTime, err
+	}
+
+	// 机器人在第 t 秒的位置可以直接算出，所以只需为被采样的那些秒画帧。
+	for t := 0; t < treeTime; t++ {
+		anim.Step(func() *image.Paletted { return robotsFrame(robots, Width, Height, t) })
+	}
+	anim.Frame(robotsFrame(robots, Width, Height, treeTime))
+	return treeTime, nil
+}
+
+// robotsFrame 画出第 t 秒时所有机器人的位置。
+func robotsFrame(robots []Robot, width, height, t int) *image.Paletted {
+	occupied := grid.New[bool](width, height)
+	for _, r := range robots {
+		occupied.Set(grid.Point{Row: mod(r.Py+r.Vy*t, height), Col: mod(r.Px+r.Vx*t, width)}, true)
+	}
+	return render.Image(occupied, func(_ grid.Point, robot bool) color.Color {
+		if robot {
+			return render.Green
+		}
+		return render.Black
+	})
+}
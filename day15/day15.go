@@ -0,0 +1,74 @@
+// Package day15 实现第 15 天（Warehouse Woes）的两部分求解。
+package day15
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/parse"
+)
+
+// moveDirections 把移动指令字符映射为坐标增量，其他字符不会移动机器人。
+var moveDirections = map[rune]grid.Point{
+	'^': grid.Up,
+	'v': grid.Down,
+	'<': grid.Left,
+	'>': grid.Right,
+}
+
+// Warehouse 是解析后的谜题输入：仓库地图和机器人的移动序列。
+type Warehouse struct {
+	Map   *grid.Grid[rune]
+	Moves []grid.Point
+}
+
+// Parse 从 r 读取仓库地图和移动指令，两者之间以空行分隔。
+// 地图只能包含 "#.O@"，移动指令只能包含 "^v<>"（可以跨多行）。
+func Parse(r io.Reader) (*Warehouse, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Warehouse{}
+	for start, block := range parse.Blocks(text) {
+		if w.Map == nil {
+			if w.Map, err = parseMap(start, block); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for i, line := range strings.Split(block, "\n") {
+			for j, char := range line {
+				d, ok := moveDirections[char]
+				if !ok {
+					return nil, parse.Errorf(start+i, parse.Col(line, j), "invalid move %q", char)
+				}
+				w.Moves = append(w.Moves, d)
+			}
+		}
+	}
+	if w.Map == nil || w.Moves == nil {
+		return nil, errors.New("invalid input format: expected map and moves separated by a blank line")
+	}
+	return w, nil
+}
+
+// parseMap 把从第 start 行开始的地图解析为字符网格，并检查其中的字符。
+func parseMap(start int, block string) (*grid.Grid[rune], error) {
+	g, err := grid.ParseRunes(block)
+	if err != nil {
+		return nil, parse.At(start, 0, err)
+	}
+	for p, char := range g.All() {
+		if !strings.ContainsRune("#.O@", char) {
+			return nil, parse.Errorf(start+p.Row, p.Col+1, "invalid map tile %q", char)
+		}
+	}
+	if _, ok := grid.Locate(g, '@'); !ok {
+		return nil, parse.Errorf(start, 0, "no robot '@' in map")
+	}
+	return g, nil
+}
@@ -0,0 +1,118 @@
+package day15
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part1 返回机器人完成移动后所有箱子的 GPS 坐标之和。
+func Part1(input string) (int, error) {
+	w, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return simulate(w), nil
+}
+
+// getRobotAndBoxes 辅助函数：从初始地图中解析出机器人和所有箱子的位置。
+func getRobotAndBoxes(g *grid.Grid[rune]) (grid.Point, []grid.Point) {
+	robotPos, _ := grid.Locate(g, '@')
+	return robotPos, grid.LocateAll(g, 'O')
+}
+
+// calculateGPSCoordinates 辅助函数：计算所有箱子的GPS坐标之和。
+// GPS坐标 = 100 * (行) + (列)。
+func calculateGPSCoordinates(boxes []grid.Point) int {
+	totalGPS := 0
+	for _, box := range boxes {
+		totalGPS += 100*box.Row + box.Col
+	}
+	return totalGPS
+}
+
+// findBoxAt 检查某个位置是否有箱子，并返回箱子在切片中的索引。
+// `ignoreIndex` 参数用于在查找时忽略特定索引的箱子。
+// 在链式推动的场景下，ignoreIndex 通常设置为 -1，因为我们需要找到目标位置的任何箱子。
+func findBoxAt(boxes []grid.Point, p grid.Point, ignoreIndex int) (int, bool) {
+	for i, box := range boxes {
+		if i == ignoreIndex { // 如果是需要忽略的箱子，跳过
+			continue
+		}
+		if box == p {
+			return i, true
+		}
+	}
+	return -1, false // 未找到箱子
+}
+
+// solveWarehouse 接收初始地图字符串和移动指令字符串，
+// 返回模拟结束后箱子的GPS坐标总和。
+func solveWarehouse(initialMapStr string, rawMoves string) (int, error) {
+	w, err := Parse(strings.NewReader(initialMapStr + "\n\n" + rawMoves))
+	if err != nil {
+		return 0, err
+	}
+	return simulate(w), nil
+}
+
+// simulate 是核心模拟函数：模拟机器人和箱子的移动，
+// 并返回最终箱子的GPS坐标总和。
+func simulate(w *Warehouse) int {
+	g := w.Map.Clone() // 使用地图的深拷贝进行操作
+	robotPos, boxes := getRobotAndBoxes(g)
+
+	// 清理初始地图显示，将 @ 和 O 的位置变成 .
+	// 这样做是为了在后续的碰撞检测中，grid 只反映墙壁，方便判断。
+	g.Set(robotPos, '.')
+	for _, box := range boxes {
+		g.Set(box, '.')
+	}
+
+nextMove:
+	for _, d := range w.Moves {
+		nextRobotPos := robotPos.Add(d)
+
+		// 检查机器人是否会移动到地图边界外，或目标位置是否是墙
+		if cell, ok := g.Get(nextRobotPos); !ok || cell == '#' {
+			continue // 机器人试图移出地图或撞墙，不移动
+		}
+
+		// 检查机器人目标位置是否有箱子（通过箱子列表判断，而不是清理后的grid）
+		_, hasBox := findBoxAt(boxes, nextRobotPos, -1) // 初始查找不忽略任何箱子
+
+		if hasBox { // 机器人尝试推动箱子
+			// 收集要推动的箱子链
+			var pushChain []int // 存储箱子在 boxes 切片中的索引
+			currentPush := nextRobotPos
+
+			for {
+				foundBoxIndex, isBox := findBoxAt(boxes, currentPush, -1) // 查找当前位置的箱子
+				if !isBox {
+					break // 遇到空地，链条结束
+				}
+				pushChain = append(pushChain, foundBoxIndex)
+
+				// 检查链条的下一个位置
+				currentPush = currentPush.Add(d)
+
+				// 如果链条末端会撞墙或出界，则整个链条不移动
+				if cell, ok := g.Get(currentPush); !ok || cell == '#' {
+					continue nextMove // 跳到下一个循环迭代 (整个推动失败)
+				}
+			}
+
+			// 如果链条可以移动，则反向更新所有箱子的位置
+			// 从链条末端开始移动，避免覆盖
+			for j := len(pushChain) - 1; j >= 0; j-- {
+				idx := pushChain[j]
+				boxes[idx] = boxes[idx].Add(d)
+			}
+		}
+
+		// 机器人移动到新位置
+		robotPos = nextRobotPos
+	}
+
+	return calculateGPSCoordinates(boxes)
+}
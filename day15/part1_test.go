@@ -1,7 +1,9 @@
-package main
+package day15
 
 import (
 	"testing"
+
+	"adventofcode/grid"
 )
 
 // TestCase 结构体定义了一个测试用例的输入和期望输出。
@@ -14,23 +16,23 @@ type TestCase struct {
 
 // printDebugMap 辅助函数：用于在测试调试时打印当前地图状态。
 // 它会根据机器人和箱子的当前位置动态构建并打印地图。
-func printDebugMap(t *testing.T, baseGrid [][]rune, robotPos point, boxes []point, moveIdx int, moveRune rune) {
+func printDebugMap(t *testing.T, baseGrid *grid.Grid[rune], robotPos grid.Point, boxes []grid.Point, moveIdx int, moveRune rune) {
 	t.Helper() // 标记为辅助函数，错误报告会指向调用它的地方
 
 	// 克隆一个只包含墙壁的基础网格
-	tempGrid := cloneGrid(baseGrid)
+	tempGrid := baseGrid.Clone()
 
 	// 在临时网格上放置箱子
 	for _, box := range boxes {
 		// 确保箱子位置在地图范围内，避免panic
-		if box.row >= 0 && box.row < len(tempGrid) && box.col >= 0 && box.col < len(tempGrid[0]) {
-			tempGrid[box.row][box.col] = 'O'
+		if tempGrid.InBounds(box) {
+			tempGrid.Set(box, 'O')
 		}
 	}
 	// 在临时网格上放置机器人
 	// 确保机器人位置在地图范围内
-	if robotPos.row >= 0 && robotPos.row < len(tempGrid) && robotPos.col >= 0 && robotPos.col < len(tempGrid[0]) {
-		tempGrid[robotPos.row][robotPos.col] = '@'
+	if tempGrid.InBounds(robotPos) {
+		tempGrid.Set(robotPos, '@')
 	}
 
 	// 打印当前移动信息和地图状态
@@ -39,10 +41,7 @@ func printDebugMap(t *testing.T, baseGrid [][]rune, robotPos point, boxes []poin
 	} else {
 		t.Logf("--- After Move %d (%c) ---", moveIdx, moveRune)
 	}
-	for _, row := range tempGrid {
-		t.Logf("%s", string(row))
-	}
-	t.Log("\n") // 打印空行以分隔不同状态
+	t.Logf("\n%s", tempGrid) // 末尾的空行分隔不同状态
 }
 
 // TestSolveWarehouse 函数用于运行所有预定义的测试用例。
@@ -91,7 +90,10 @@ v^^>>><<^^<>>^v^<v^vv<>v^<<>^<^v^v><^<<<><<^<v><v<>vv>>v><v^<vv<>v^<<^`,
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// 调用核心模拟函数，并传递 *testing.T 实例用于打印调试信息。
-			actual := solveWarehouse(tc.warehouse, tc.moves)
+			actual, err := solveWarehouse(tc.warehouse, tc.moves)
+			if err != nil {
+				t.Fatal(err)
+			}
 			if actual != tc.expected {
 				t.Errorf("For test case %s: expected %d, got %d", tc.name, tc.expected, actual)
 				// 此时，详细的调试日志会通过 t.Logf 显示出来，帮助定位问题。
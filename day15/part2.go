@@ -0,0 +1,185 @@
+package day15
+
+import (
+	"image"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/render"
+)
+
+// Part2 返回在放大后的仓库中机器人完成移动后所有宽箱子的 GPS 坐标之和。
+func Part2(input string) (int, error) {
+	w, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return simulateWide(w, nil), nil
+}
+
+// expandMap 函数将原始地图放大：每个格子变为左右两格，箱子变为宽箱子 "[]"
+func expandMap(original *grid.Grid[rune]) *grid.Grid[rune] {
+	wide := grid.New[rune](original.Width*2, original.Height)
+	for p, char := range original.All() {
+		var pair string
+		switch char {
+		case '#':
+			pair = "##"
+		case 'O':
+			pair = "[]"
+		case '@':
+			pair = "@."
+		default:
+			pair = ".."
+		}
+		left := grid.Point{Row: p.Row, Col: p.Col * 2}
+		wide.Set(left, rune(pair[0]))
+		wide.Set(left.Add(grid.Right), rune(pair[1]))
+	}
+	return wide
+}
+
+// isBoxStart 判断 p 处是否是一个完整宽箱子的左半边 '['。
+func isBoxStart(m *grid.Grid[rune], p grid.Point) bool {
+	left, okLeft := m.Get(p)
+	right, okRight := m.Get(p.Add(grid.Right))
+	return okLeft && okRight && left == '[' && right == ']'
+}
+
+// collectPushChain 从 firstBox（箱子 '[' 的坐标）开始，用 BFS 找出沿 d 推动时
+// 会被连带推动的所有箱子。
+func collectPushChain(m *grid.Grid[rune], firstBox grid.Point, d grid.Point) []grid.Point {
+	var pushChain []grid.Point
+	queue := []grid.Point{firstBox}               // Queue stores the '[' coords of boxes to process
+	processedInChain := make(map[grid.Point]bool) // To avoid adding/processing the same box multiple times
+
+	for len(queue) > 0 {
+		currentBoxStart := queue[0]
+		queue = queue[1:]
+
+		if processedInChain[currentBoxStart] || !isBoxStart(m, currentBoxStart) {
+			continue // Already processed, or invalid box from queue
+		}
+
+		pushChain = append(pushChain, currentBoxStart)
+		processedInChain[currentBoxStart] = true
+
+		// Determine cells this currentBoxStart would try to push into
+		var cellsToInvestigate []grid.Point
+		switch {
+		case d.Row != 0: // Vertical push: checks the two cells in front of '[' and ']'
+			cellsToInvestigate = append(cellsToInvestigate, currentBoxStart.Add(d), currentBoxStart.Add(grid.Right).Add(d))
+		case d.Col > 0: // Pushing right: check cell to the right of current box's ']'
+			cellsToInvestigate = append(cellsToInvestigate, currentBoxStart.Add(grid.Right).Add(d))
+		case d.Col < 0: // Pushing left: check cell to the left of current box's '['
+			cellsToInvestigate = append(cellsToInvestigate, currentBoxStart.Add(d))
+		}
+
+		for _, probe := range cellsToInvestigate {
+			charAtProbe, ok := m.Get(probe)
+			if !ok {
+				continue // Probe point out of bounds
+			}
+
+			nextBox := probe
+			if charAtProbe == ']' { // Hit the right part of a box
+				nextBox = probe.Add(grid.Left)
+			}
+			// Check if the identified next box is valid '[]' and not yet processed
+			if !processedInChain[nextBox] && isBoxStart(m, nextBox) {
+				queue = append(queue, nextBox)
+			}
+		}
+	}
+	return pushChain
+}
+
+// canPushChain 检查链上的所有箱子沿 d 移动一格后是否会撞墙、出界或压到链外的箱子。
+func canPushChain(m *grid.Grid[rune], pushChain []grid.Point, d grid.Point) bool {
+	for _, box := range pushChain { // Check wall/boundary collision for each box in chain
+		left, okLeft := m.Get(box.Add(d))
+		right, okRight := m.Get(box.Add(d).Add(grid.Right))
+		if !okLeft || !okRight || left == '#' || right == '#' {
+			return false
+		}
+	}
+
+	// Check inter-box collision (non-chain boxes)
+	tempMap := m.Clone()
+	for _, box := range pushChain { // Clear chain boxes from tempMap
+		tempMap.Set(box, '.')
+		tempMap.Set(box.Add(grid.Right), '.')
+	}
+	isBoxHalf := func(p grid.Point) bool {
+		r := tempMap.At(p)
+		return r == '[' || r == ']'
+	}
+	for _, box := range pushChain {
+		newBox := box.Add(d)
+		if isBoxHalf(newBox) || isBoxHalf(newBox.Add(grid.Right)) {
+			return false
+		}
+	}
+	return true
+}
+
+// solvePart2 接收原始地图字符串和移动指令字符串，在放大后的仓库中模拟移动
+func solvePart2(warehouseMapStr, movesStr string) (int, error) {
+	w, err := Parse(strings.NewReader(warehouseMapStr + "\n\n" + movesStr))
+	if err != nil {
+		return 0, err
+	}
+	return simulateWide(w, nil), nil
+}
+
+// simulateWide 模拟机器人和宽箱子在放大仓库中的移动，返回宽箱子的GPS坐标总和。
+// anim 不为 nil 时记录每一次移动后的仓库。
+func simulateWide(w *Warehouse, anim *render.Animation) int {
+	warehouseMap := expandMap(w.Map)
+	robotPos, _ := grid.Locate(warehouseMap, '@')
+
+	for _, d := range w.Moves {
+		nextRobotPos := robotPos.Add(d)
+
+		cell, ok := warehouseMap.Get(nextRobotPos)
+		if !ok || cell == '#' {
+			continue
+		}
+
+		if cell == '[' || cell == ']' {
+			firstBoxHit := nextRobotPos
+			if cell == ']' {
+				firstBoxHit = firstBoxHit.Add(grid.Left)
+			}
+
+			pushChain := collectPushChain(warehouseMap, firstBoxHit, d)
+			if len(pushChain) == 0 || !canPushChain(warehouseMap, pushChain, d) {
+				continue
+			}
+
+			for _, box := range pushChain { // Clear old positions of chain boxes
+				warehouseMap.Set(box, '.')
+				warehouseMap.Set(box.Add(grid.Right), '.')
+			}
+			for _, box := range pushChain { // Place chain boxes in new positions
+				warehouseMap.Set(box.Add(d), '[')
+				warehouseMap.Set(box.Add(d).Add(grid.Right), ']')
+			}
+		}
+
+		// Move robot
+		warehouseMap.Set(robotPos, '.')
+		robotPos = nextRobotPos
+		warehouseMap.Set(robotPos, '@')
+		anim.Step(func() *image.Paletted { return render.Image(warehouseMap, warehouseStyle) })
+	}
+	if anim != nil {
+		anim.Frame(render.Image(warehouseMap, warehouseStyle))
+	}
+
+	totalGPSCoordinates := 0
+	for _, box := range grid.LocateAll(warehouseMap, '[') {
+		totalGPSCoordinates += box.Row*100 + box.Col
+	}
+	return totalGPSCoordinates
+}
@@ -1,4 +1,4 @@
-package main
+package day15
 
 import (
 	"testing"
@@ -81,7 +81,10 @@ func TestSolvePart2TableDriven(t *testing.T) {
 		// Use t.Run to run subtests for each test case.
 		// This makes the test output clearer if multiple cases fail.
 		t.Run(tt.name, func(t *testing.T) {
-			actualGPS := solvePart2(tt.warehouse, tt.moves)
+			actualGPS, err := solvePart2(tt.warehouse, tt.moves)
+			if err != nil {
+				t.Fatal(err)
+			}
 			if actualGPS != tt.wantGPSSum {
 				t.Errorf("solvePart2() = %d, want %d", actualGPS, tt.wantGPSSum)
 			}
@@ -0,0 +1,33 @@
+package day15
+
+import (
+	"image/color"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/render"
+)
+
+// RenderPart2 与 Part2 相同，同时把机器人在放大仓库中的每一次移动记录到 anim 中。
+func RenderPart2(input string, anim *render.Animation) (int, error) {
+	w, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return simulateWide(w, anim), nil
+}
+
+// warehouseStyle 给仓库地图中的墙、箱子和机器人着色。
+func warehouseStyle(_ grid.Point, r rune) color.Color {
+	switch r {
+	case '#':
+		return render.Gray
+	case 'O', '[':
+		return render.Brown
+	case ']':
+		return render.Orange // 宽箱子的右半边换一种颜色，相邻的箱子才分得清
+	case '@':
+		return render.Yellow
+	}
+	return render.Black
+}
@@ -0,0 +1,66 @@
+// Package day16 实现第 16 天（Reindeer Maze）的两部分求解。
+package day16
+
+import (
+	"io"
+	"iter"
+
+	"adventofcode/grid"
+	"adventofcode/parse"
+	"adventofcode/search"
+)
+
+// Parse 从 r 读取迷宫网格
+func Parse(r io.Reader) (*grid.Grid[rune], error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	return grid.ParseRunes(text)
+}
+
+// 方向常量
+const (
+	East  = 0 // 东
+	South = 1 // 南
+	West  = 2 // 西
+	North = 3 // 北
+)
+
+// 对应方向的坐标变化量: headings[East] ...
+var headings = [4]grid.Point{grid.Right, grid.Down, grid.Left, grid.Up}
+
+// State 代表搜索过程中的一个状态：位置 (Pos) 和朝向 (Dir)
+type State struct {
+	Pos grid.Point
+	Dir int
+}
+
+// moves 返回迷宫中每个状态的后继：前进一步（若不是墙），或原地顺/逆时针旋转90度
+func moves(maze *grid.Grid[rune]) search.Neighbors[State] {
+	return func(s State) iter.Seq[State] {
+		return func(yield func(State) bool) {
+			// 操作1: 前进一步，检查新位置是否有效 (界内、非墙)
+			next := s.Pos.Add(headings[s.Dir])
+			if tile, ok := maze.Get(next); ok && tile != '#' {
+				if !yield(State{Pos: next, Dir: s.Dir}) {
+					return
+				}
+			}
+			// 操作2: 顺时针旋转90度 (0E -> 1S -> 2W -> 3N -> 0E)
+			if !yield(State{Pos: s.Pos, Dir: (s.Dir + 1) % 4}) {
+				return
+			}
+			// 操作3: 逆时针旋转90度，`+4` 确保结果为正
+			yield(State{Pos: s.Pos, Dir: (s.Dir - 1 + 4) % 4})
+		}
+	}
+}
+
+// moveCost 前进一步得 1 分，旋转得 1000 分
+func moveCost(from, to State) int {
+	if from.Dir != to.Dir {
+		return 1000
+	}
+	return 1
+}
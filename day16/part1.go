@@ -0,0 +1,37 @@
+package day16
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/search"
+)
+
+// Part1 返回从 'S' 到 'E' 可以获得的最低分数，无法到达时返回 -1。
+func Part1(input string) (int, error) {
+	maze, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findLowestScore(maze), nil
+}
+
+// findLowestScore 函数计算从 'S' 到 'E' 的最低分数
+func findLowestScore(maze *grid.Grid[rune]) int {
+	// 寻找起点 'S'（空迷宫中自然也找不到）
+	start, ok := grid.Locate(maze, 'S')
+	if !ok {
+		return -1
+	}
+
+	// 初始状态：在 'S' 位置，朝向东；第一次到达 'E' 时 Dijkstra 保证分数最低
+	result := search.Dijkstra(State{Pos: start, Dir: East}, moves(maze), moveCost, search.Options[State]{
+		Goal: func(s State) bool { return maze.At(s.Pos) == 'E' },
+	})
+	end, ok := result.Goal()
+	if !ok {
+		return -1 // 无法到达 'E'
+	}
+	score, _ := result.Dist(end)
+	return score
+}
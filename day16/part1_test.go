@@ -1,7 +1,8 @@
-// main_test.go
-package main
+// part1_test.go
+package day16
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -59,8 +60,12 @@ func TestFindLowestScore(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// 调用 main.go 中的 findLowestScore 函数
-			got := findLowestScore(tt.maze)
+			// 调用 part1.go 中的 findLowestScore 函数
+			maze, err := Parse(strings.NewReader(strings.Join(tt.maze, "\n")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := findLowestScore(maze)
 			if got != tt.expectedScore {
 				t.Errorf("findLowestScore(%s) 测试失败: 得到 %v, 期望 %v", tt.name, got, tt.expectedScore)
 			}
@@ -0,0 +1,60 @@
+package day16
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/render"
+	"adventofcode/search"
+)
+
+// Part2 返回位于至少一条最佳路径上的图块数量。
+func Part2(input string) (int, error) {
+	maze, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return countTilesOnBestPath(maze, nil), nil
+}
+
+// countTilesOnBestPath (Part 2 函数)。anim 不为 nil 时记录搜索过程和最佳路径。
+func countTilesOnBestPath(maze *grid.Grid[rune], anim *render.Animation) int {
+	start, ok := grid.Locate(maze, 'S')
+	if !ok {
+		return 0
+	}
+
+	// 完整运行 Dijkstra，并记录所有最短路径上的前驱
+	result := search.Dijkstra(State{Pos: start, Dir: East}, moves(maze), moveCost, search.Options[State]{AllPaths: true})
+
+	// 找出到达任何'E'图块的最低分数，以及以该分数到达'E'的所有状态
+	minScoreToE := -1
+	var bestEnds []State
+	for _, end := range grid.LocateAll(maze, 'E') {
+		for dir := 0; dir < 4; dir++ { // 检查所有4个方向
+			s := State{Pos: end, Dir: dir}
+			score, ok := result.Dist(s)
+			switch {
+			case !ok || (minScoreToE != -1 && score > minScoreToE):
+				continue
+			case minScoreToE == -1 || score < minScoreToE:
+				minScoreToE = score
+				bestEnds = bestEnds[:0]
+			}
+			bestEnds = append(bestEnds, s)
+		}
+	}
+	if minScoreToE == -1 {
+		return 0 // 'E' 不可达
+	}
+
+	// 沿前驱 DAG 回溯，统计最佳路径经过的不同图块
+	onBestPathTiles := make(map[grid.Point]bool)
+	for s := range result.OnShortestPaths(bestEnds...) {
+		onBestPathTiles[s.Pos] = true
+	}
+	if anim != nil {
+		renderSearch(anim, maze, result, onBestPathTiles)
+	}
+	return len(onBestPathTiles)
+}
@@ -1,8 +1,11 @@
-// main_test.go
-package main
+// part2_test.go
+package day16
 
 import (
+	"strings"
 	"testing"
+
+	"adventofcode/render"
 )
 
 // TestCountTilesOnBestPath (Part 2 测试)
@@ -68,9 +71,9 @@ func TestCountTilesOnBestPath(t *testing.T) {
 		{
 			name: "S to E direct",
 			maze: []string{
-				"###",
+				"####",
 				"#SE#",
-				"###",
+				"####",
 			},
 			expectedCount: 2,
 		},
@@ -85,10 +88,37 @@ func TestCountTilesOnBestPath(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := countTilesOnBestPath(tt.maze)
+			maze, err := Parse(strings.NewReader(strings.Join(tt.maze, "\n")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := countTilesOnBestPath(maze, nil)
 			if got != tt.expectedCount {
 				t.Errorf("countTilesOnBestPath(%s) = %v, want %v", tt.name, got, tt.expectedCount)
 			}
+
+			// 渲染不应改变结果，且最后一帧中标为最佳路径的图块数应与答案一致
+			anim := render.NewAnimation()
+			if got := countTilesOnBestPath(maze, anim); got != tt.expectedCount {
+				t.Errorf("countTilesOnBestPath(%s, anim) = %v, want %v", tt.name, got, tt.expectedCount)
+			}
+			frames := anim.Frames()
+			if tt.expectedCount == 0 {
+				return
+			}
+			if len(frames) == 0 {
+				t.Fatal("no frames rendered")
+			}
+			last := frames[len(frames)-1]
+			yellow := 0
+			for _, c := range last.Pix {
+				if last.Palette[c] == render.Yellow {
+					yellow++
+				}
+			}
+			if yellow != tt.expectedCount {
+				t.Errorf("last frame has %d best-path tiles, want %d", yellow, tt.expectedCount)
+			}
 		})
 	}
 }
@@ -0,0 +1,61 @@
+package day16
+
+import (
+	"cmp"
+	"image"
+	"image/color"
+	"maps"
+	"slices"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/render"
+	"adventofcode/search"
+)
+
+// RenderPart2 与 Part2 相同，同时把 Dijkstra 的搜索过程和所有最佳路径上的
+// 图块记录到 anim 中。
+func RenderPart2(input string, anim *render.Animation) (int, error) {
+	maze, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return countTilesOnBestPath(maze, anim), nil
+}
+
+// mazeStyle 把墙画成深灰色，起点和终点分别画成绿色和红色。
+func mazeStyle(_ grid.Point, r rune) color.Color {
+	switch r {
+	case '#':
+		return render.DarkGray
+	case 'S':
+		return render.Green
+	case 'E':
+		return render.Red
+	}
+	return render.Black
+}
+
+// renderSearch 按图块被搜索到达的先后顺序（最低分数从小到大）逐帧展开已探索的
+// 区域，最后一帧再画上所有最佳路径上的图块。
+func renderSearch(anim *render.Animation, maze *grid.Grid[rune], result *search.Result[State], best map[grid.Point]bool) {
+	reached := make(map[grid.Point]int)
+	for s, d := range result.Distances() {
+		if old, ok := reached[s.Pos]; !ok || d < old {
+			reached[s.Pos] = d
+		}
+	}
+	order := slices.SortedFunc(maps.Keys(reached), func(a, b grid.Point) int {
+		return cmp.Or(cmp.Compare(reached[a], reached[b]), cmp.Compare(a.Row, b.Row), cmp.Compare(a.Col, b.Col))
+	})
+
+	for i := range order {
+		anim.Step(func() *image.Paletted {
+			return render.Image(maze, mazeStyle, render.Overlay{Color: render.Blue, Points: slices.Values(order[:i+1])})
+		})
+	}
+	anim.Frame(render.Image(maze, mazeStyle,
+		render.Overlay{Color: render.Blue, Points: slices.Values(order)},
+		render.Overlay{Color: render.Yellow, Points: maps.Keys(best)},
+	))
+}
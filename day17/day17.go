@@ -0,0 +1,87 @@
+// Package day17 实现第 17 天（Chronospatial Computer）的两部分求解。
+package day17
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// Computer 是解析后的谜题输入：三个寄存器的初始值和程序。
+// 程序是 3 位数（0–7）组成的指令、操作数序列。
+type Computer struct {
+	A, B, C int
+	Program []int
+}
+
+// Parse 从 r 读取寄存器和程序：
+//
+//	Register A: 729
+//	Register B: 0
+//	Register C: 0
+//
+//	Program: 0,1,5,4,3,0
+func Parse(r io.Reader) (*Computer, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Computer{}
+	registers := []struct {
+		prefix string
+		value  *int
+	}{
+		{"Register A:", &c.A},
+		{"Register B:", &c.B},
+		{"Register C:", &c.C},
+	}
+	next := 0 // 下一个要读取的寄存器
+	for lineNo, line := range parse.Lines(text) {
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+		case next < len(registers):
+			reg := registers[next]
+			if !strings.HasPrefix(line, reg.prefix) {
+				return nil, parse.Errorf(lineNo, 1, "want %q", reg.prefix)
+			}
+			fields := parse.Fields(line[len(reg.prefix):], nil)
+			if len(fields) != 1 {
+				return nil, parse.Errorf(lineNo, len(reg.prefix)+1, "want a single value after %q", reg.prefix)
+			}
+			fields[0].Col += len(reg.prefix)
+			if *reg.value, err = fields[0].Int(lineNo); err != nil {
+				return nil, err
+			}
+			next++
+		case c.Program == nil:
+			const prefix = "Program:"
+			if !strings.HasPrefix(line, prefix) {
+				return nil, parse.Errorf(lineNo, 1, "want %q", prefix)
+			}
+			for _, f := range parse.Fields(line[len(prefix):], parse.Comma) {
+				f.Col += len(prefix)
+				v, err := f.Int(lineNo)
+				if err != nil {
+					return nil, err
+				}
+				if v < 0 || v > 7 {
+					return nil, parse.Errorf(lineNo, f.Col, "program value %d is not a 3-bit number", v)
+				}
+				c.Program = append(c.Program, v)
+			}
+			if c.Program == nil {
+				return nil, parse.Errorf(lineNo, len(prefix)+1, "empty program")
+			}
+		default:
+			return nil, parse.Errorf(lineNo, 1, "unexpected line after the program")
+		}
+	}
+	if c.Program == nil {
+		return nil, errors.New("missing registers or \"Program:\" line")
+	}
+	return c, nil
+}
@@ -0,0 +1,34 @@
+package day17
+
+import (
+	"strings"
+
+	"adventofcode/vm"
+)
+
+// budget 是运行谜题程序时最多执行的指令条数。谜题程序每轮把 A 除以 8，
+// 几十轮内就会停机；超出预算说明程序陷入了死循环。
+const budget = 1 << 20
+
+// Part1 运行程序，返回用逗号连接的全部输出。
+func Part1(input string) (string, error) {
+	c, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return "", err
+	}
+	output, err := run(c)
+	if err != nil {
+		return "", err
+	}
+	return vm.Join(output), nil
+}
+
+// run 按 c 中的初始寄存器运行程序，返回全部输出。
+func run(c *Computer) ([]int, error) {
+	m, err := vm.New(c.Program, c.A, c.B, c.C)
+	if err != nil {
+		return nil, err
+	}
+	m.Budget = budget
+	return m.Run()
+}
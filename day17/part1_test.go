@@ -0,0 +1,71 @@
+package day17
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name: "谜题示例",
+			input: `Register A: 729
+Register B: 0
+Register C: 0
+
+Program: 0,1,5,4,3,0
+`,
+			want: "4,6,3,5,6,3,5,2,1,0",
+		},
+		{
+			name: "输出自身的程序",
+			input: `Register A: 117440
+Register B: 0
+Register C: 0
+
+Program: 0,3,5,4,3,0
+`,
+			want: "0,3,5,4,3,0",
+		},
+		{
+			name:  "没有输出",
+			input: "Register A: 0\nRegister B: 0\nRegister C: 0\n\nProgram: 1,7\n",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Part1(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Part1() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPart1Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"保留的组合操作数", "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 0,7\n", "reserved combo operand"},
+		{"死循环", "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 3,0\n", "budget"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Part1(tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Part1() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package day17
+
+import (
+	"fmt"
+	"strings"
+
+	"adventofcode/vm"
+)
+
+// Part2 返回使程序输出自身的最低正初始 A 值。
+// 程序必须是 vm.FindQuine 支持的循环结构（每轮把 A 右移 3 位并输出一个值）。
+func Part2(input string) (int, error) {
+	c, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, fmt.Errorf("无法解析输入: %w", err)
+	}
+	return vm.FindQuine(c.Program, c.B, c.C)
+}
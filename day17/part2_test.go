@@ -0,0 +1,128 @@
+package day17
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"adventofcode/vm"
+)
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name:  "example",
+			input: "Register A: 2024\nRegister B: 0\nRegister C: 0\n\nProgram: 0,3,5,4,3,0\n",
+			want:  117440,
+		},
+		{
+			name:  "puzzle shape",
+			input: "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 2,4,1,3,7,5,0,3,1,5,4,4,5,5,3,0\n",
+			want:  236539226447469,
+		},
+		{
+			// 另一种常见的谜题程序：out 之前 adv，用 C 与 B 异或
+			name:  "other puzzle shape",
+			input: "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 2,4,1,1,7,5,1,5,0,3,4,4,5,5,3,0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Part2(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want != 0 && got != tt.want {
+				t.Errorf("Part2() = %d, want %d", got, tt.want)
+			}
+
+			// 答案必须让程序输出自身，且任何更小的 A 都不行
+			c, _ := Parse(strings.NewReader(tt.input))
+			c.A = got
+			if output, err := run(c); err != nil || vm.Join(output) != vm.Join(c.Program) {
+				t.Errorf("A=%d outputs %v (err %v), want the program itself", got, output, err)
+			}
+		})
+	}
+}
+
+func TestPart2Lowest(t *testing.T) {
+	// 短程序可以穷举验证答案是最小的
+	const input = "Register A: 0\nRegister B: 0\nRegister C: 0\n\nProgram: 0,3,5,4,3,0\n"
+	got, err := Part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := Parse(strings.NewReader(input))
+	for a := 1; a < got; a++ {
+		c.A = a
+		if output, _ := run(c); vm.Join(output) == vm.Join(c.Program) {
+			t.Fatalf("A=%d is a smaller quine than %d", a, got)
+		}
+	}
+}
+
+func TestPart2Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		want    error
+	}{
+		{"no jump at the end", "0,3,5,4", vm.ErrUnsupported},
+		{"jump elsewhere", "0,3,5,4,3,2", vm.ErrUnsupported},
+		{"jump inside the loop", "0,3,3,0,5,4,3,0", vm.ErrUnsupported},
+		{"shift by 2", "0,2,5,4,3,0", vm.ErrUnsupported},
+		{"two outputs", "0,3,5,4,5,4,3,0", vm.ErrUnsupported},
+		{"no shift", "5,4,3,0", vm.ErrUnsupported},
+		{"B carried across iterations", "0,3,1,1,5,5,3,0", vm.ErrUnsupported},
+		{"constant output", "0,3,5,0,3,0", vm.ErrNoQuine},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := "Register A: 0\nRegister B: 0\nRegister C: 0\n\nProgram: " + tt.program + "\n"
+			if _, err := Part2(input); !errors.Is(err, tt.want) {
+				t.Errorf("Part2() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	var opErr *vm.OperandError
+	if _, err := vm.FindQuine([]int{0, 3, 5, 7, 3, 0}, 0, 0); !errors.As(err, &opErr) {
+		t.Errorf("FindQuine() with combo operand 7 = %v, want *vm.OperandError", err)
+	}
+}
+
+func TestFindQuineConcurrent(t *testing.T) {
+	// 求解器没有包级状态，可以并发调用
+	programs := [][]int{
+		{0, 3, 5, 4, 3, 0},
+		{2, 4, 1, 3, 7, 5, 0, 3, 1, 5, 4, 4, 5, 5, 3, 0},
+	}
+	want := make([]int, len(programs))
+	for i, p := range programs {
+		var err error
+		if want[i], err = vm.FindQuine(p, 0, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p := programs[i%len(programs)]
+			if got, err := vm.FindQuine(p, 0, 0); err != nil || got != want[i%len(programs)] {
+				t.Errorf("FindQuine(%v) = %d, %v, want %d", p, got, err, want[i%len(programs)])
+			}
+		}()
+	}
+	wg.Wait()
+}
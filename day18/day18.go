@@ -0,0 +1,71 @@
+// Package day18 实现第 18 天（RAM Run）的两部分求解。
+package day18
+
+import (
+	"io"
+	"iter"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/parse"
+	"adventofcode/search"
+)
+
+// 实际谜题要求的网格尺寸
+const gridSize = 71
+
+// Parse 从 r 读取字节坐标，每行形如 "X,Y"，X 是列、Y 是行；空行会被忽略。
+func Parse(r io.Reader) ([]grid.Point, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	var bytePositions []grid.Point
+	for lineNo, line := range parse.Lines(text) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		nums, err := parse.Ints(lineNo, line, parse.Comma)
+		if err != nil {
+			return nil, err
+		}
+		if len(nums) != 2 {
+			return nil, parse.Errorf(lineNo, 0, "want \"X,Y\", got %q", line)
+		}
+		bytePositions = append(bytePositions, grid.Point{Row: nums[1], Col: nums[0]})
+	}
+	return bytePositions, nil
+}
+
+// openNeighbors 返回在网格内且未被破坏的相邻坐标
+func openNeighbors(corrupted *grid.Grid[bool]) search.Neighbors[grid.Point] {
+	return func(p grid.Point) iter.Seq[grid.Point] {
+		return func(yield func(grid.Point) bool) {
+			for next := range corrupted.Neighbors4(p) {
+				if !corrupted.At(next) && !yield(next) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// shortestPath 用 BFS 求从左上角到右下角的最少步数，找不到路径时返回 -1
+func shortestPath(corrupted *grid.Grid[bool]) int {
+	start := grid.Point{Row: 0, Col: 0}
+	end := grid.Point{Row: corrupted.Height - 1, Col: corrupted.Width - 1}
+
+	// 如果起点或终点是障碍物，则无解
+	if corrupted.At(start) || corrupted.At(end) {
+		return -1
+	}
+
+	result := search.BFS(start, openNeighbors(corrupted), search.Options[grid.Point]{
+		Goal: func(p grid.Point) bool { return p == end },
+	})
+	if _, ok := result.Goal(); !ok {
+		return -1
+	}
+	steps, _ := result.Dist(end)
+	return steps
+}
@@ -0,0 +1,28 @@
+package day18
+
+import (
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part1 返回前 1024 个字节坠落后从左上角到右下角的最少步数。
+func Part1(input string) (int, error) {
+	bytePositions, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return findShortestPath(gridSize, gridSize, 1024, bytePositions), nil
+}
+
+// findShortestPath 标记前 byteCount 个字节后，使用广度优先搜索 (BFS) 寻找最短路径
+func findShortestPath(width, height, byteCount int, bytePositions []grid.Point) int {
+	// 创建网格并标记障碍物，true 表示被破坏
+	memory := grid.New[bool](width, height)
+	for i := 0; i < byteCount && i < len(bytePositions); i++ {
+		if p := bytePositions[i]; memory.InBounds(p) {
+			memory.Set(p, true)
+		}
+	}
+	return shortestPath(memory)
+}
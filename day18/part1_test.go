@@ -1,25 +1,36 @@
-package main
+package day18
 
 import (
+	"strings"
 	"testing"
+
+	"adventofcode/grid"
 )
 
+// parseExample 解析测试用的字节坐标，失败时终止测试。
+func parseExample(t *testing.T, input string) []grid.Point {
+	t.Helper()
+	bytePositions, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytePositions
+}
+
 func TestFindShortestPath(t *testing.T) {
 	// 谜题中提供的示例数据
-	exampleBytes := []Point{
-		{5, 4},
-		{4, 2},
-		{4, 5},
-		{3, 0},
-		{2, 1},
-		{6, 3},
-		{2, 4},
-		{1, 5},
-		{0, 6},
-		{3, 3},
-		{2, 6},
-		{5, 1},
-	}
+	exampleBytes := parseExample(t, `5,4
+4,2
+4,5
+3,0
+2,1
+6,3
+2,4
+1,5
+0,6
+3,3
+2,6
+5,1`)
 
 	// 定义测试用例表
 	tests := []struct {
@@ -27,7 +38,7 @@ func TestFindShortestPath(t *testing.T) {
 		width         int
 		height        int
 		byteCount     int
-		bytePositions []Point
+		bytePositions []grid.Point
 		want          int
 	}{
 		{
@@ -0,0 +1,58 @@
+package day18
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// Part2 返回第一个阻断出口路径的字节坐标，格式为 "X,Y"。
+func Part2(input string) (string, error) {
+	bytePositions, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return "", err
+	}
+	blockingByte, found := findBlockingByte(gridSize, gridSize, bytePositions)
+	if !found {
+		return "", errors.New("no byte was found that blocked the path")
+	}
+	return fmt.Sprintf("%d,%d", blockingByte.Col, blockingByte.Row), nil
+}
+
+// pathExists 检查从左上角到右下角的路径是否存在。
+func pathExists(corrupted *grid.Grid[bool]) bool {
+	return shortestPath(corrupted) != -1
+}
+
+// findBlockingByte 模拟字节坠落，找到第一个阻塞路径的字节。
+// 字节只增不减，路径一旦被阻断就不会恢复，因此可以对坠落的字节数做二分查找。
+func findBlockingByte(width, height int, bytePositions []grid.Point) (grid.Point, bool) {
+	blocked := func(byteCount int) bool {
+		corrupted := grid.New[bool](width, height)
+		for _, p := range bytePositions[:byteCount] {
+			if corrupted.InBounds(p) { // 网格外的字节不会影响路径
+				corrupted.Set(p, true)
+			}
+		}
+		return !pathExists(corrupted)
+	}
+
+	// 所有字节坠落后路径依然存在
+	if !blocked(len(bytePositions)) {
+		return grid.Point{Row: -1, Col: -1}, false
+	}
+
+	// 找到最小的 n，使前 n 个字节坠落后路径被阻断；第 n 个字节即为答案
+	lo, hi := 0, len(bytePositions)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if blocked(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return bytePositions[lo-1], true
+}
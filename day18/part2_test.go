@@ -1,46 +1,46 @@
-package main
+package day18
 
 import (
 	"reflect"
 	"testing"
+
+	"adventofcode/grid"
 )
 
 func TestFindBlockingByte(t *testing.T) {
 	// 谜题中提供的完整示例字节列表
-	exampleBytes := []Point{
-		{5, 4},
-		{4, 2},
-		{4, 5},
-		{3, 0},
-		{2, 1},
-		{6, 3},
-		{2, 4},
-		{1, 5},
-		{0, 6},
-		{3, 3},
-		{2, 6},
-		{5, 1},
-		{1, 2},
-		{5, 5},
-		{2, 5},
-		{6, 5},
-		{1, 4},
-		{0, 4},
-		{6, 4},
-		{1, 1},
-		{6, 1},
-		{1, 0},
-		{0, 5},
-		{1, 6},
-		{2, 0},
-	}
+	exampleBytes := parseExample(t, `5,4
+4,2
+4,5
+3,0
+2,1
+6,3
+2,4
+1,5
+0,6
+3,3
+2,6
+5,1
+1,2
+5,5
+2,5
+6,5
+1,4
+0,4
+6,4
+1,1
+6,1
+1,0
+0,5
+1,6
+2,0`)
 
 	tests := []struct {
 		name          string
 		width         int
 		height        int
-		bytePositions []Point
-		wantPoint     Point
+		bytePositions []grid.Point
+		wantPoint     grid.Point
 		wantFound     bool
 	}{
 		{
@@ -48,7 +48,7 @@ func TestFindBlockingByte(t *testing.T) {
 			width:         7,
 			height:        7,
 			bytePositions: exampleBytes,
-			wantPoint:     Point{6, 1}, // 根据描述，这个字节是第一个阻塞路径的
+			wantPoint:     grid.Point{Row: 1, Col: 6}, // 即 "6,1"，根据描述，这个字节是第一个阻塞路径的
 			wantFound:     true,
 		},
 	}
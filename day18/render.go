@@ -0,0 +1,65 @@
+package day18
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"slices"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/render"
+	"adventofcode/search"
+)
+
+// RenderPart2 与 Part2 相同，同时把字节逐个坠落的过程记录到 anim 中：
+// 每帧画出已坠落的字节和当时的一条最短路径，最后一帧标出阻断路径的字节。
+func RenderPart2(input string, anim *render.Animation) (string, error) {
+	bytePositions, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return "", err
+	}
+	blockingByte, found := findBlockingByte(gridSize, gridSize, bytePositions)
+	if !found {
+		return "", errors.New("no byte was found that blocked the path")
+	}
+	answer := fmt.Sprintf("%d,%d", blockingByte.Col, blockingByte.Row)
+	if anim == nil {
+		return answer, nil
+	}
+
+	corrupted := grid.New[bool](gridSize, gridSize)
+	for _, p := range bytePositions[:slices.Index(bytePositions, blockingByte)] {
+		if corrupted.InBounds(p) {
+			corrupted.Set(p, true)
+		}
+		anim.Step(func() *image.Paletted {
+			return render.Image(corrupted, memoryStyle, render.Overlay{Color: render.Green, Points: slices.Values(exitPath(corrupted))})
+		})
+	}
+	corrupted.Set(blockingByte, true)
+	anim.Frame(render.Image(corrupted, memoryStyle, render.Overlay{Color: render.Red, Points: slices.Values([]grid.Point{blockingByte})}))
+	return answer, nil
+}
+
+// memoryStyle 把被破坏的格子画成灰色。
+func memoryStyle(_ grid.Point, corrupted bool) color.Color {
+	if corrupted {
+		return render.Gray
+	}
+	return render.Black
+}
+
+// exitPath 返回从左上角到右下角的一条最短路径，不存在时返回 nil。
+func exitPath(corrupted *grid.Grid[bool]) []grid.Point {
+	start := grid.Point{Row: 0, Col: 0}
+	end := grid.Point{Row: corrupted.Height - 1, Col: corrupted.Width - 1}
+	if corrupted.At(start) {
+		return nil
+	}
+	result := search.BFS(start, openNeighbors(corrupted), search.Options[grid.Point]{
+		Goal: func(p grid.Point) bool { return p == end },
+	})
+	return result.Path(end)
+}
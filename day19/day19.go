@@ -0,0 +1,64 @@
+// Package day19 实现第 19 天（Linen Layout）的两部分求解。
+package day19
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// colors 是毛巾条纹可以使用的颜色。
+const colors = "wubrg"
+
+// Towels 是解析后的谜题输入：可用的毛巾模式和想要拼出的设计。
+type Towels struct {
+	Patterns []string
+	Designs  []string
+}
+
+// Parse 从 r 读取毛巾模式（第一段，逗号分隔）和空行之后的设计列表。
+func Parse(r io.Reader) (*Towels, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Towels{}
+	for start, block := range parse.Blocks(text) {
+		for i, line := range strings.Split(block, "\n") {
+			lineNo := start + i
+			if t.Patterns == nil {
+				if i > 0 {
+					return nil, parse.Errorf(lineNo, 1, "patterns must be on a single line")
+				}
+				for _, f := range parse.Fields(line, parse.Comma) {
+					if err := checkColors(lineNo, f); err != nil {
+						return nil, err
+					}
+					t.Patterns = append(t.Patterns, f.Text)
+				}
+				continue
+			}
+			if err := checkColors(lineNo, parse.Field{Text: line, Col: 1}); err != nil {
+				return nil, err
+			}
+			t.Designs = append(t.Designs, line)
+		}
+	}
+	if t.Patterns == nil {
+		return nil, errors.New("输入为空或无法读取第一行")
+	}
+	return t, nil
+}
+
+// checkColors 检查字段中只包含合法的颜色字符。
+func checkColors(lineNo int, f parse.Field) error {
+	for i, c := range f.Text {
+		if !strings.ContainsRune(colors, c) {
+			return parse.Errorf(lineNo, f.Col+parse.Col(f.Text, i)-1, "invalid stripe color %q, want one of %q", c, colors)
+		}
+	}
+	return nil
+}
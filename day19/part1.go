@@ -0,0 +1,42 @@
+package day19
+
+import "strings"
+
+// Part1 返回可以由毛巾模式拼接而成的设计数量。
+func Part1(input string) (int, error) {
+	towels, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	patterns, designs := towels.Patterns, towels.Designs
+
+	possibleDesignsCount := 0
+	for _, design := range designs {
+		if CanFormDesign(design, patterns) {
+			possibleDesignsCount++
+		}
+	}
+	return possibleDesignsCount, nil
+}
+
+// CanFormDesign 判断设计能否由给定的毛巾模式拼接而成
+func CanFormDesign(design string, patterns []string) bool {
+	patternSet := make(map[string]bool)
+	for _, p := range patterns {
+		patternSet[p] = true
+	}
+
+	dp := make([]bool, len(design)+1)
+	dp[0] = true
+
+	for i := 1; i <= len(design); i++ {
+		for j := 0; j < i; j++ {
+			if dp[j] && patternSet[design[j:i]] {
+				dp[i] = true
+				break
+			}
+		}
+	}
+
+	return dp[len(design)]
+}
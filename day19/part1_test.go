@@ -1,4 +1,4 @@
-package main
+package day19
 
 import (
 	"testing" // 引入 Go 的测试包
@@ -0,0 +1,57 @@
+package day19
+
+import "strings"
+
+// Part2 返回所有设计的拼接方法总数。
+func Part2(input string) (int, error) {
+	towels, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	patterns, designs := towels.Patterns, towels.Designs
+
+	totalWays := 0
+	for _, design := range designs {
+		totalWays += CountWays(design, patterns)
+	}
+	return totalWays, nil
+}
+
+// CountWays 计算一个设计可以由一组毛巾模式拼接而成的方法总数。
+//
+// 参数:
+//
+//	design - 目标设计字符串。
+//	patterns - 包含所有可用毛巾模式的字符串切片。
+//
+// 返回:
+//
+//	可以构成该设计的不同方法的总数。
+//
+// 实现思路:
+// 动态规划。dp[i] 存储构成 design[:i] 的方法总数。
+// dp[0] = 1 (构成空字符串有1种方法)。
+// 状态转移方程: dp[i] = Σ dp[j]，对于所有 j < i 且 design[j:i] 是有效模式的情况。
+func CountWays(design string, patterns []string) int {
+	patternSet := make(map[string]bool)
+	for _, p := range patterns {
+		patternSet[p] = true
+	}
+
+	// dp[i] 存储构成前 i 个字符的方法数
+	dp := make([]int, len(design)+1)
+	dp[0] = 1 // 基础案例：构成空字符串有 1 种方法
+
+	for i := 1; i <= len(design); i++ {
+		// 遍历所有可能的分割点 j
+		for j := 0; j < i; j++ {
+			// 如果子字符串 design[j:i] 是一个有效的毛巾模式
+			if patternSet[design[j:i]] {
+				// 将构成 design[:j] 的方法数累加到 dp[i]
+				dp[i] += dp[j]
+			}
+		}
+	}
+
+	return dp[len(design)]
+}
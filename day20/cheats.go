@@ -0,0 +1,183 @@
+package day20
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// ErrNoPath is returned when the end cannot be reached from the start.
+var ErrNoPath = errors.New("no path from S to E")
+
+// Metric returns the length of a cheat that moves by d. It must be at least
+// max(|d.Row|, |d.Col|) so that every cheat within a radius lies inside the
+// square of that radius.
+type Metric func(d grid.Point) int
+
+var (
+	// Manhattan is the puzzle's metric: a cheat moves one tile up, down, left
+	// or right per picosecond, so the cells within a radius form a diamond.
+	Manhattan Metric = func(d grid.Point) int { return abs(d.Row) + abs(d.Col) }
+	// Chebyshev also allows diagonal moves, so the cells form a square.
+	Chebyshev Metric = func(d grid.Point) int { return max(abs(d.Row), abs(d.Col)) }
+)
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Options configures a cheat analysis.
+type Options struct {
+	// Radius is the longest cheat, in picoseconds.
+	Radius int
+	// MinSaving is the least a cheat must save to be counted. Cheats that
+	// save nothing are never counted.
+	MinSaving int
+	// Metric measures cheat lengths; nil means Manhattan.
+	Metric Metric
+	// Collect records every counted cheat in Analysis.Cheats.
+	Collect bool
+}
+
+// Cheat is one way to pass through walls: it starts on the track at Start,
+// ends on the track at End and takes Length picoseconds.
+type Cheat struct {
+	Start, End     grid.Point
+	Length, Saving int
+}
+
+// SavingCount is one row of the savings histogram.
+type SavingCount struct {
+	Saving, Count int
+}
+
+// Analysis is the result of Racetrack.Cheats.
+type Analysis struct {
+	// BaseTime is the time from S to E without cheating.
+	BaseTime int
+	// Count is the number of cheats that save at least Options.MinSaving.
+	Count int
+	// Histogram counts those cheats by how much they save, smallest saving first.
+	Histogram []SavingCount
+	// Cheats lists them when Options.Collect is set, ordered by start and then
+	// end position in row-major order.
+	Cheats []Cheat
+}
+
+// Cheats finds every cheat of at most opts.Radius picoseconds that saves at
+// least opts.MinSaving. A cheat is identified by its start and end positions,
+// so the same pair is counted once however the walls between them are crossed.
+// Only the cells within the radius of each track tile are visited, not every
+// pair of track tiles.
+func (rt *Racetrack) Cheats(opts Options) (*Analysis, error) {
+	if opts.Radius < 0 {
+		return nil, fmt.Errorf("cheat radius %d is negative", opts.Radius)
+	}
+	metric := opts.Metric
+	if metric == nil {
+		metric = Manhattan
+	}
+	fromStart := rt.distances(rt.Start)
+	toEnd := rt.distances(rt.End)
+	base := fromStart.At(rt.End)
+	if base < 0 {
+		return nil, ErrNoPath
+	}
+
+	// The offsets within the radius, in row-major order.
+	var offsets []grid.Point
+	for dRow := -opts.Radius; dRow <= opts.Radius; dRow++ {
+		for dCol := -opts.Radius; dCol <= opts.Radius; dCol++ {
+			d := grid.Point{Row: dRow, Col: dCol}
+			if n := metric(d); n > 0 && n <= opts.Radius {
+				offsets = append(offsets, d)
+			}
+		}
+	}
+
+	a := &Analysis{BaseTime: base}
+	minSaving := max(opts.MinSaving, 1)
+	counts := make(map[int]int)
+	for _, start := range rt.Tiles {
+		before := fromStart.At(start)
+		if before < 0 {
+			continue
+		}
+		for _, d := range offsets {
+			end := start.Add(d)
+			after, ok := toEnd.Get(end)
+			if !ok || after < 0 {
+				continue
+			}
+			length := metric(d)
+			saving := base - (before + length + after)
+			if saving < minSaving {
+				continue
+			}
+			counts[saving]++
+			if opts.Collect {
+				a.Cheats = append(a.Cheats, Cheat{Start: start, End: end, Length: length, Saving: saving})
+			}
+		}
+	}
+
+	for saving, n := range counts {
+		a.Count += n
+		a.Histogram = append(a.Histogram, SavingCount{saving, n})
+	}
+	slices.SortFunc(a.Histogram, func(x, y SavingCount) int { return x.Saving - y.Saving })
+	return a, nil
+}
+
+// distances returns the distance from p to every track tile, -1 where the
+// tile is a wall or cannot be reached.
+func (rt *Racetrack) distances(p grid.Point) *grid.Grid[int] {
+	g := grid.New[int](rt.Map.Width, rt.Map.Height)
+	g.Fill(-1)
+	for q, d := range bfs(p, rt.Map) {
+		g.Set(q, d)
+	}
+	return g
+}
+
+// WriteTable writes the histogram the way the puzzle lists it, e.g.
+// "There are 14 cheats that save 2 picoseconds."
+func (a *Analysis) WriteTable(w io.Writer) error {
+	for _, row := range a.Histogram {
+		var err error
+		if row.Count == 1 {
+			_, err = fmt.Fprintf(w, "There is one cheat that saves %d picoseconds.\n", row.Saving)
+		} else {
+			_, err = fmt.Fprintf(w, "There are %d cheats that save %d picoseconds.\n", row.Count, row.Saving)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countCheats parses input and counts the cheats of at most radius
+// picoseconds that save at least 100 picoseconds. If E cannot be reached
+// from S there is nothing to save, so the count is 0.
+func countCheats(input string, radius int) (int, error) {
+	rt, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	a, err := rt.Cheats(Options{Radius: radius, MinSaving: 100})
+	if errors.Is(err, ErrNoPath) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return a.Count, nil
+}
@@ -0,0 +1,169 @@
+package day20
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"adventofcode/grid"
+)
+
+const example = `###############
+#...#...#.....#
+#.#.#.#.#.###.#
+#S#...#.#.#...#
+#######.#.#.###
+#######.#.#...#
+#######.#.###.#
+###..E#...#...#
+###.#######.###
+#...###...#...#
+#.#####.#.###.#
+#.#...#.#.#...#
+#.#.#.#.#.#.###
+#...#...#...###
+###############
+`
+
+func parseExample(t *testing.T) *Racetrack {
+	t.Helper()
+	rt, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rt
+}
+
+func TestCheatTable(t *testing.T) {
+	rt := parseExample(t)
+	a, err := rt.Cheats(Options{Radius: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The puzzle's table for cheats of up to 2 picoseconds.
+	want := `There are 14 cheats that save 2 picoseconds.
+There are 14 cheats that save 4 picoseconds.
+There are 2 cheats that save 6 picoseconds.
+There are 4 cheats that save 8 picoseconds.
+There are 2 cheats that save 10 picoseconds.
+There are 3 cheats that save 12 picoseconds.
+There is one cheat that saves 20 picoseconds.
+There is one cheat that saves 36 picoseconds.
+There is one cheat that saves 38 picoseconds.
+There is one cheat that saves 40 picoseconds.
+There is one cheat that saves 64 picoseconds.
+`
+	var b strings.Builder
+	if err := a.WriteTable(&b); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != want {
+		t.Errorf("WriteTable:\n%s\nwant:\n%s", b.String(), want)
+	}
+	if a.BaseTime != 84 || a.Count != 44 {
+		t.Errorf("BaseTime = %d, Count = %d; want 84, 44", a.BaseTime, a.Count)
+	}
+}
+
+func TestCheatHistogram(t *testing.T) {
+	rt := parseExample(t)
+	a, err := rt.Cheats(Options{Radius: 20, MinSaving: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The puzzle's table for cheats of up to 20 picoseconds saving at least 50.
+	want := []SavingCount{
+		{50, 32}, {52, 31}, {54, 29}, {56, 39}, {58, 25}, {60, 23}, {62, 20},
+		{64, 19}, {66, 12}, {68, 14}, {70, 12}, {72, 22}, {74, 4}, {76, 3},
+	}
+	if len(a.Histogram) != len(want) {
+		t.Fatalf("Histogram = %v, want %v", a.Histogram, want)
+	}
+	total := 0
+	for i, row := range a.Histogram {
+		if row != want[i] {
+			t.Errorf("Histogram[%d] = %v, want %v", i, row, want[i])
+		}
+		total += row.Count
+	}
+	if a.Count != total || a.Cheats != nil {
+		t.Errorf("Count = %d with %d cheats listed, want %d and none", a.Count, len(a.Cheats), total)
+	}
+}
+
+// bruteCheats compares every ordered pair of track tiles.
+func bruteCheats(rt *Racetrack, opts Options) map[[2]grid.Point]int {
+	fromStart, toEnd := rt.distances(rt.Start), rt.distances(rt.End)
+	base := fromStart.At(rt.End)
+	cheats := make(map[[2]grid.Point]int)
+	for _, p := range rt.Tiles {
+		for _, q := range rt.Tiles {
+			n := opts.Metric(q.Sub(p))
+			if n == 0 || n > opts.Radius || fromStart.At(p) < 0 || toEnd.At(q) < 0 {
+				continue
+			}
+			if saving := base - fromStart.At(p) - n - toEnd.At(q); saving >= max(opts.MinSaving, 1) {
+				cheats[[2]grid.Point{p, q}] = saving
+			}
+		}
+	}
+	return cheats
+}
+
+func TestCheatsMatchBruteForce(t *testing.T) {
+	rt := parseExample(t)
+	for _, metric := range []struct {
+		name   string
+		metric Metric
+	}{{"manhattan", Manhattan}, {"chebyshev", Chebyshev}} {
+		for _, radius := range []int{0, 1, 2, 3, 6, 20, 40} {
+			opts := Options{Radius: radius, MinSaving: 4, Metric: metric.metric, Collect: true}
+			a, err := rt.Cheats(opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := bruteCheats(rt, opts)
+			if a.Count != len(want) || len(a.Cheats) != len(want) {
+				t.Errorf("%s radius %d: %d cheats (%d listed), want %d", metric.name, radius, a.Count, len(a.Cheats), len(want))
+				continue
+			}
+			for i, c := range a.Cheats {
+				if saving, ok := want[[2]grid.Point{c.Start, c.End}]; !ok || saving != c.Saving || c.Length != metric.metric(c.End.Sub(c.Start)) {
+					t.Errorf("%s radius %d: unexpected cheat %+v", metric.name, radius, c)
+				}
+				if i > 0 {
+					prev := a.Cheats[i-1]
+					if before(c.Start, prev.Start) || c.Start == prev.Start && !before(prev.End, c.End) {
+						t.Errorf("%s radius %d: cheats out of order: %+v after %+v", metric.name, radius, c, prev)
+					}
+				}
+			}
+		}
+	}
+}
+
+// before reports whether p comes before q in row-major order.
+func before(p, q grid.Point) bool {
+	return p.Row < q.Row || p.Row == q.Row && p.Col < q.Col
+}
+
+func TestCheatErrors(t *testing.T) {
+	rt := parseExample(t)
+	if _, err := rt.Cheats(Options{Radius: -1}); err == nil {
+		t.Error("negative radius: want error")
+	}
+
+	walled, err := Parse(strings.NewReader("#####\n#S#E#\n#####\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := walled.Cheats(Options{Radius: 2}); !errors.Is(err, ErrNoPath) {
+		t.Errorf("unreachable end: got %v, want ErrNoPath", err)
+	}
+	// Part1 and Part2 still count no cheats rather than failing.
+	for _, part := range []func(string) (int, error){Part1, Part2} {
+		if n, err := part("#####\n#S#E#\n#####\n"); n != 0 || err != nil {
+			t.Errorf("unreachable end: Part1/Part2 = %d, %v, want 0, nil", n, err)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Package day20 implements both parts of day 20 (Race Condition).
+package day20
+
+import (
+	"errors"
+	"io"
+	"iter"
+
+	"adventofcode/grid"
+	"adventofcode/parse"
+	"adventofcode/search"
+)
+
+// Racetrack is the parsed puzzle input. S and E are replaced by ordinary
+// track so that every walkable tile is '.', and all track tiles are listed in
+// row-major order.
+type Racetrack struct {
+	Map        *grid.Grid[rune]
+	Start, End grid.Point
+	Tiles      []grid.Point
+}
+
+// Parse reads the racetrack from r.
+func Parse(r io.Reader) (*Racetrack, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	track, err := grid.ParseRunes(text)
+	if err != nil {
+		return nil, err
+	}
+
+	start, okStart := grid.Locate(track, 'S')
+	end, okEnd := grid.Locate(track, 'E')
+	if !okStart || !okEnd {
+		return nil, errors.New("racetrack needs both S and E")
+	}
+	track.Set(start, '.') // Treat S as track
+	track.Set(end, '.')   // Treat E as track
+
+	// After replacing S and E, find all track tiles
+	return &Racetrack{Map: track, Start: start, End: end, Tiles: grid.LocateAll(track, '.')}, nil
+}
+
+// bfs returns the distance from start to every reachable track tile.
+func bfs(start grid.Point, track *grid.Grid[rune]) map[grid.Point]int {
+	onTrack := func(p grid.Point) iter.Seq[grid.Point] {
+		return func(yield func(grid.Point) bool) {
+			for next := range track.Neighbors4(p) {
+				if track.At(next) == '.' && !yield(next) {
+					return
+				}
+			}
+		}
+	}
+	return search.BFS(start, onTrack, search.Options[grid.Point]{}).Distances()
+}
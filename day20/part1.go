@@ -0,0 +1,7 @@
+package day20
+
+// Part1 returns the number of cheats of at most 2 picoseconds that save at
+// least 100 picoseconds.
+func Part1(input string) (int, error) {
+	return countCheats(input, 2)
+}
@@ -1,10 +1,10 @@
-package main
+package day20
 
 import (
 	"testing"
 )
 
-func TestSolve(t *testing.T) {
+func TestSolvePart1(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
@@ -35,8 +35,12 @@ func TestSolve(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := solve(tt.input); got != tt.want {
-				t.Errorf("solve() = %v, want %v", got, tt.want)
+			got, err := Part1(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("solvePart1() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -0,0 +1,7 @@
+package day20
+
+// Part2 returns the number of cheats of at most 20 picoseconds that save at
+// least 100 picoseconds.
+func Part2(input string) (int, error) {
+	return countCheats(input, 20)
+}
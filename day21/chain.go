@@ -0,0 +1,276 @@
+package day21
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+
+	"adventofcode/grid"
+	"adventofcode/search"
+)
+
+// Chain 是一串相互操作的键盘。Keypads[0] 是最终要输入代码的键盘（门上的数字键盘），
+// Keypads[i+1] 上的按键驱动操作 Keypads[i] 的机械臂，最后一块由人直接按。
+// 除第一块外的键盘都必须有四个方向键和激活键。
+type Chain struct {
+	Keypads []*Keypad
+	// Costs[i] 给出在 Keypads[i] 上按键的代价。为 nil 或比 Keypads 短时，缺少的层使用
+	// 谜题的模型：人按的最后一块键盘每键代价 1，机器人的按键不计代价。
+	Costs []CostModel
+}
+
+// NewChain 返回由 door、depth 块机器人操作的 robot 键盘和人按的一块 robot 键盘组成的链。
+// 谜题第一部分的 depth 是 2，第二部分是 25。
+func NewChain(door, robot *Keypad, depth int) (Chain, error) {
+	if depth < 0 {
+		return Chain{}, fmt.Errorf("depth %d is negative", depth)
+	}
+	keypads := []*Keypad{door}
+	for range depth + 1 {
+		keypads = append(keypads, robot)
+	}
+	c := Chain{Keypads: keypads}
+	return c, c.check()
+}
+
+// PuzzleChain 返回谜题中的链：一块数字键盘加上 depth 层机器人操作的方向键盘。
+func PuzzleChain(depth int) (Chain, error) {
+	return NewChain(mustKeypad(NumericLayout), mustKeypad(DirectionalLayout), depth)
+}
+
+// check 确认每块驱动别的键盘的键盘上都有方向键和激活键，并且所有按键的代价都非负。
+func (c Chain) check() error {
+	if len(c.Keypads) == 0 {
+		return errors.New("chain has no keypads")
+	}
+	for i, k := range c.Keypads[1:] {
+		if !k.Has(Activate) {
+			return fmt.Errorf("keypad %d has no %q key", i+1, Activate)
+		}
+		for _, m := range moves {
+			if !k.Has(m.key) {
+				return fmt.Errorf("keypad %d drives keypad %d but has no %q key", i+1, i, m.key)
+			}
+		}
+	}
+	return c.checkCosts()
+}
+
+// costKey 标识在第 layer 块键盘上把机械臂从 from 移到 to 并按下的一次移动。
+type costKey struct {
+	layer    int
+	from, to rune
+}
+
+// move 是一次移动的最优驱动方式：下一层键盘上要按的键 keys，
+// 它们在下面各层产生的总代价 cost（不含按下 to 本身），以及人要按的次数 presses。
+type move struct {
+	cost, presses int
+	keys          string
+}
+
+// armState 是驱动第 layer 块键盘时的搜索状态：这块键盘上机械臂的位置、
+// 下一层机械臂指向的键，以及目标键是否已经按下。
+type armState struct {
+	pos   grid.Point
+	under rune
+	done  bool
+}
+
+// presser 计算在链上输入序列的最小代价，缓存每层每对按键之间的最优移动。
+// 每次调用各自创建，不在调用之间共享。
+type presser struct {
+	chain Chain
+	cache map[costKey]move
+}
+
+func (c Chain) presser() *presser {
+	return &presser{chain: c, cache: make(map[costKey]move)}
+}
+
+// sequence 返回在第 layer 块键盘上依次按下 keys 的总代价和人要按的次数，机械臂从 A 出发。
+func (p *presser) sequence(layer int, keys string) (cost, presses int, err error) {
+	from := Activate
+	for _, to := range keys {
+		c, n, err := p.press(layer, from, to)
+		if err != nil {
+			return 0, 0, err
+		}
+		cost += c
+		presses += n
+		from = to
+	}
+	return cost, presses, nil
+}
+
+// press 返回把第 layer 块键盘上的机械臂从 from 移到 to 再按下的总代价和人要按的次数。
+// 最后一块键盘由人直接按，没有机械臂。
+func (p *presser) press(layer int, from, to rune) (cost, presses int, err error) {
+	if !p.chain.Keypads[layer].Has(to) {
+		return 0, 0, fmt.Errorf("keypad %d has no %q key", layer, to)
+	}
+	cost = p.chain.cost(layer, to)
+	if layer == len(p.chain.Keypads)-1 {
+		return cost, 1, nil
+	}
+	m, err := p.move(layer, from, to)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cost + m.cost, m.presses, nil
+}
+
+// move 返回把第 layer 块键盘上的机械臂从 from 移到 to 并按下的最优方式。
+// 按键代价不一定相同，绕远的路可能更便宜，所以在（机械臂位置，下一层机械臂指向的键）
+// 上做 Dijkstra 搜索，而不是只比较最短路径。
+func (p *presser) move(layer int, from, to rune) (move, error) {
+	key := costKey{layer, from, to}
+	if m, ok := p.cache[key]; ok {
+		return m, nil
+	}
+
+	k := p.chain.Keypads[layer]
+	target := k.keys[to]
+	neighbors := func(s armState) iter.Seq[armState] {
+		return func(yield func(armState) bool) {
+			if s.done {
+				return
+			}
+			if s.pos == target && !yield(armState{s.pos, Activate, true}) {
+				return
+			}
+			for _, m := range moves {
+				next := s.pos.Add(m.delta)
+				if key, ok := k.grid.Get(next); ok && key != ' ' && !yield(armState{next, m.key, false}) {
+					return
+				}
+			}
+		}
+	}
+	// 下一层每按一个键，代价是把它的机械臂移过去再按下。
+	var err error
+	cost := func(s, t armState) int {
+		n, _, e := p.press(layer+1, s.under, t.under)
+		if e != nil {
+			err = e
+		}
+		return n
+	}
+	start := armState{k.keys[from], Activate, false}
+	r := search.Dijkstra(start, neighbors, cost, search.Options[armState]{
+		Goal: func(s armState) bool { return s.done },
+	})
+	if err != nil {
+		return move{}, err
+	}
+	goal, ok := r.Goal()
+	if !ok {
+		return move{}, fmt.Errorf("keypad %d: cannot move from %q to %q", layer, from, to)
+	}
+
+	var keys strings.Builder
+	for _, s := range r.Path(goal)[1:] {
+		keys.WriteRune(s.under)
+	}
+	m := move{keys: keys.String()}
+	m.cost, m.presses, err = p.sequence(layer+1, m.keys)
+	if err != nil {
+		return move{}, err
+	}
+	p.cache[key] = m
+	return m, nil
+}
+
+// Presses 返回在第一块键盘上输入 code 的最小总代价。使用谜题的代价模型时，
+// 这就是人要在链的最后一块键盘上按的次数。
+func (c Chain) Presses(code string) (int, error) {
+	if err := c.check(); err != nil {
+		return 0, err
+	}
+	cost, _, err := c.presser().sequence(0, code)
+	return cost, err
+}
+
+// Complexity 返回所有代码的复杂度之和：每个代码的最小总代价乘以它的数字部分。
+func (c Chain) Complexity(codes []string) (int, error) {
+	if err := c.check(); err != nil {
+		return 0, err
+	}
+	p := c.presser()
+	total := 0
+	for _, code := range codes {
+		cost, _, err := p.sequence(0, code)
+		if err != nil {
+			return 0, fmt.Errorf("code %s: %w", code, err)
+		}
+		total += cost * numericPart(code)
+	}
+	return total, nil
+}
+
+// numericPart 返回代码中的数字组成的整数，忽略前导零和其他字符；没有数字时为 0。
+func numericPart(code string) int {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, code)
+	n, _ := strconv.Atoi(digits)
+	return n
+}
+
+// solve 返回经过 depth 层方向键盘机器人时所有代码的复杂度之和。
+func solve(input string, depth int) (int, error) {
+	codes, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	if len(codes) == 0 {
+		return 0, errors.New("input is empty")
+	}
+	chain, err := PuzzleChain(depth)
+	if err != nil {
+		return 0, err
+	}
+	return chain.Complexity(codes)
+}
+
+// ErrTooLong 表示最优按键序列比调用方允许的更长，无法展开。
+var ErrTooLong = errors.New("key sequence is too long to materialize")
+
+// Sequence 返回人在最后一块键盘上输入 code 的一条总代价最小的按键序列。
+// 序列长度超过 limit 时返回 ErrTooLong；谜题第二部分的序列有上千亿个按键，只能算长度。
+func (c Chain) Sequence(code string, limit int) (string, error) {
+	if err := c.check(); err != nil {
+		return "", err
+	}
+	p := c.presser()
+	_, n, err := p.sequence(0, code)
+	if err != nil {
+		return "", err
+	}
+	if n > limit {
+		return "", fmt.Errorf("%w: %d presses, limit %d", ErrTooLong, n, limit)
+	}
+	var b strings.Builder
+	b.Grow(n)
+	p.expand(&b, 0, code)
+	return b.String(), nil
+}
+
+// expand 把第 layer 块键盘上的按键序列 keys 展开成最后一块键盘上的按键，写入 b。
+// keys 必须已经由 sequence 算过，每次移动都在缓存中。
+func (p *presser) expand(b *strings.Builder, layer int, keys string) {
+	if layer == len(p.chain.Keypads)-1 {
+		b.WriteString(keys)
+		return
+	}
+	from := Activate
+	for _, to := range keys {
+		p.expand(b, layer+1, p.cache[costKey{layer, from, to}].keys)
+		from = to
+	}
+}
@@ -0,0 +1,119 @@
+package day21
+
+import (
+	"strings"
+	"testing"
+)
+
+const example = `029A
+980A
+179A
+456A
+379A
+`
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		name  string
+		solve func(string) (int, error)
+		want  int
+	}{
+		{"part 1", Part1, 126384},
+		{"part 2", Part2, 154115708116294},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.solve(example)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+	if _, err := Part1("\n"); err == nil {
+		t.Error("Part1 of empty input: want error")
+	}
+}
+
+func TestPresses(t *testing.T) {
+	tests := []struct {
+		code  string
+		depth int
+		want  int
+	}{
+		// 谜题中给出的各层序列长度：<A^A>^^AvvvA、v<<A>>^A<A>AvA<^AA>A<vAAA>^A 等。
+		{"029A", 0, 12},
+		{"029A", 1, 28},
+		{"029A", 2, 68},
+		{"980A", 2, 60},
+		{"179A", 2, 68},
+		{"456A", 2, 64},
+		{"379A", 2, 64},
+		{"", 2, 0},
+	}
+	for _, tt := range tests {
+		chain, err := PuzzleChain(tt.depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := chain.Presses(tt.code)
+		if err != nil {
+			t.Errorf("Presses(%q) at depth %d: %v", tt.code, tt.depth, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Presses(%q) at depth %d = %d, want %d", tt.code, tt.depth, got, tt.want)
+		}
+	}
+}
+
+func TestCustomChain(t *testing.T) {
+	// 一排按键的门：从 A 移到 3 再按下要 >>>A，到 1 还要 <<A。
+	door, err := ParseKeypad("A123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	directional := mustKeypad(DirectionalLayout)
+
+	chain, err := NewChain(door, directional, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := chain.Presses("31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 7 {
+		t.Errorf("Presses(\"31\") = %d, want 7", got)
+	}
+	if _, err := chain.Presses("4"); err == nil || !strings.Contains(err.Error(), `'4'`) {
+		t.Errorf("Presses with a missing key: got error %v", err)
+	}
+
+	// 操作别的键盘的键盘必须有方向键。
+	if _, err := NewChain(directional, door, 1); err == nil {
+		t.Error("NewChain with a robot keypad without arrows: want error")
+	}
+	if _, err := NewChain(door, directional, -1); err == nil {
+		t.Error("NewChain with negative depth: want error")
+	}
+
+	// 链越长按键越多。
+	prev := 0
+	for depth := range 10 {
+		chain, err := NewChain(door, directional, depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, err := chain.Presses("3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n <= prev {
+			t.Errorf("depth %d: %d presses, not more than %d at depth %d", depth, n, prev, depth-1)
+		}
+		prev = n
+	}
+}
@@ -0,0 +1,49 @@
+package day21
+
+import "fmt"
+
+// CostModel 返回在某块键盘上按一次 key 的代价，必须非负。
+type CostModel func(key rune) int
+
+// Uniform 返回每个按键代价都是 cost 的模型。
+func Uniform(cost int) CostModel {
+	return func(rune) int { return cost }
+}
+
+// KeyCosts 返回按 costs 计价的模型，costs 中没有的按键代价为 other。
+// 例如 KeyCosts(map[rune]int{'<': 3}, 1) 表示 < 键要按得慢一些。
+func KeyCosts(costs map[rune]int, other int) CostModel {
+	return func(key rune) int {
+		if c, ok := costs[key]; ok {
+			return c
+		}
+		return other
+	}
+}
+
+// cost 返回在第 layer 块键盘上按 key 的代价。Costs 中没有给出这一层时使用谜题的模型：
+// 人按的最后一块键盘每键代价 1，机器人的按键不计代价。
+func (c Chain) cost(layer int, key rune) int {
+	if layer < len(c.Costs) && c.Costs[layer] != nil {
+		return c.Costs[layer](key)
+	}
+	if layer == len(c.Keypads)-1 {
+		return 1
+	}
+	return 0
+}
+
+// checkCosts 确认每块键盘上每个按键的代价都非负。
+func (c Chain) checkCosts() error {
+	if len(c.Costs) > len(c.Keypads) {
+		return fmt.Errorf("%d cost models for %d keypads", len(c.Costs), len(c.Keypads))
+	}
+	for layer, k := range c.Keypads {
+		for _, key := range k.Keys() {
+			if n := c.cost(layer, key); n < 0 {
+				return fmt.Errorf("keypad %d: key %q has negative cost %d", layer, key, n)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package day21
+
+import (
+	"iter"
+	"math/rand/v2"
+	"testing"
+
+	"adventofcode/grid"
+	"adventofcode/search"
+)
+
+// bruteState 是整条链的状态：每条机械臂的位置和已经输入的字符数。
+type bruteState struct {
+	arms  [4]grid.Point
+	typed int
+}
+
+// bruteStep 模拟人按下 key，返回新状态和这一下在各层产生的代价。
+// 有机械臂离开按键，或者门上输入了 code 之外的字符时返回 false。
+func bruteStep(c Chain, s bruteState, key rune, code string) (bruteState, int, bool) {
+	last := len(c.Keypads) - 1
+	cost := c.cost(last, key)
+	for layer := last; ; layer-- {
+		if layer == 0 {
+			if s.typed == len(code) || rune(code[s.typed]) != key {
+				return s, 0, false
+			}
+			s.typed++
+			return s, cost, true
+		}
+		arm := &s.arms[layer-1]
+		if key != Activate {
+			d, _ := arrow(key)
+			*arm = arm.Add(d)
+			k, ok := c.Keypads[layer-1].grid.Get(*arm)
+			return s, cost, ok && k != ' '
+		}
+		key = c.Keypads[layer-1].grid.At(*arm)
+		cost += c.cost(layer-1, key)
+	}
+}
+
+func bruteStart(c Chain) bruteState {
+	var s bruteState
+	for i := range len(c.Keypads) - 1 {
+		s.arms[i] = c.Keypads[i].keys[Activate]
+	}
+	return s
+}
+
+// bruteCost 在整条链的状态空间上做 Dijkstra，返回输入 code 的最小总代价。
+func bruteCost(t *testing.T, c Chain, code string) int {
+	t.Helper()
+	keys := c.Keypads[len(c.Keypads)-1].Keys()
+	neighbors := func(s bruteState) iter.Seq[bruteState] {
+		return func(yield func(bruteState) bool) {
+			for _, key := range keys {
+				if next, _, ok := bruteStep(c, s, key, code); ok && !yield(next) {
+					return
+				}
+			}
+		}
+	}
+	cost := func(s, next bruteState) int {
+		best := -1
+		for _, key := range keys {
+			if n, w, ok := bruteStep(c, s, key, code); ok && n == next && (best < 0 || w < best) {
+				best = w
+			}
+		}
+		return best
+	}
+	r := search.Dijkstra(bruteStart(c), neighbors, cost, search.Options[bruteState]{
+		Goal: func(s bruteState) bool { return s.typed == len(code) },
+	})
+	goal, ok := r.Goal()
+	if !ok {
+		t.Fatalf("brute force cannot type %q", code)
+	}
+	d, _ := r.Dist(goal)
+	return d
+}
+
+func TestWeightedCosts(t *testing.T) {
+	rng := rand.New(rand.NewPCG(21, 22))
+	numeric, directional := mustKeypad(NumericLayout), mustKeypad(DirectionalLayout)
+	codes := []string{"029A", "980A", "179A", "456A", "379A"}
+
+	randomModel := func() CostModel {
+		costs := make(map[rune]int)
+		for _, key := range "^v<>A0123456789" {
+			costs[key] = rng.IntN(10)
+		}
+		return KeyCosts(costs, 0)
+	}
+	for trial := range 20 {
+		depth := trial % 3
+		chain, err := NewChain(numeric, directional, depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range depth + 2 {
+			chain.Costs = append(chain.Costs, randomModel())
+		}
+		code := codes[trial%len(codes)]
+
+		got, err := chain.Presses(code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := bruteCost(t, chain, code); got != want {
+			t.Errorf("trial %d (depth %d): Presses(%q) = %d, brute force %d", trial, depth, code, got, want)
+		}
+
+		seq, err := chain.Sequence(code, 1<<20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if typed, err := chain.Replay(seq); err != nil || typed != code {
+			t.Errorf("trial %d: replaying %q typed %q, %v; want %q", trial, seq, typed, err, code)
+		}
+		total, s := 0, bruteStart(chain)
+		for _, key := range seq {
+			var w int
+			s, w, _ = bruteStep(chain, s, key, code)
+			total += w
+		}
+		if total != got {
+			t.Errorf("trial %d: Sequence(%q) costs %d, Presses says %d", trial, code, total, got)
+		}
+	}
+}
+
+func TestCostModels(t *testing.T) {
+	numeric, directional := mustKeypad(NumericLayout), mustKeypad(DirectionalLayout)
+	chain, err := NewChain(numeric, directional, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		costs []CostModel
+		want  int
+	}{
+		{"puzzle model", nil, 68},
+		// 只给出人的那一层且每键代价 1，与谜题的模型相同。
+		{"explicit puzzle model", []CostModel{nil, nil, nil, Uniform(1)}, 68},
+		{"doubled", []CostModel{nil, nil, nil, Uniform(2)}, 136},
+		// 每层每键都计 1：门上 4 次，依次是 12、28、68 次。
+		{"every press counts", []CostModel{Uniform(1), Uniform(1), Uniform(1), Uniform(1)}, 4 + 12 + 28 + 68},
+		{"free", []CostModel{Uniform(0), Uniform(0), Uniform(0), Uniform(0)}, 0},
+	}
+	for _, tt := range tests {
+		c := chain
+		c.Costs = tt.costs
+		got, err := c.Presses("029A")
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: Presses = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	c := chain
+	c.Costs = []CostModel{nil, KeyCosts(map[rune]int{'<': -1}, 1)}
+	if _, err := c.Presses("029A"); err == nil {
+		t.Error("negative cost: want error")
+	}
+	c.Costs = make([]CostModel, 5)
+	if _, err := c.Presses("029A"); err == nil {
+		t.Error("more cost models than keypads: want error")
+	}
+}
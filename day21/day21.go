@@ -0,0 +1,38 @@
+// Package day21 实现第 21 天（Keypad Conundrum）的两部分求解。
+package day21
+
+import (
+	"io"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// codeChars 是门上数字键盘可以输入的字符。
+const codeChars = "0123456789A"
+
+// Parse 从 r 读取需要输入的代码，每行一个，忽略空行和行首尾的空白。
+func Parse(r io.Reader) ([]string, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	var codes []string
+	for lineNo, line := range parse.Lines(text) {
+		fields := parse.Fields(line, nil)
+		switch {
+		case len(fields) == 0:
+			continue
+		case len(fields) > 1:
+			return nil, parse.Errorf(lineNo, fields[1].Col, "want one code per line")
+		}
+		code := fields[0]
+		for i, c := range code.Text {
+			if !strings.ContainsRune(codeChars, c) {
+				return nil, parse.Errorf(lineNo, code.Col+parse.Col(code.Text, i)-1, "invalid key %q, want one of %q", c, codeChars)
+			}
+		}
+		codes = append(codes, code.Text)
+	}
+	return codes, nil
+}
@@ -0,0 +1,99 @@
+package day21
+
+import (
+	"fmt"
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// 谜题中的两种键盘布局。每行一排按键，空格是机械臂不能停留的间隙。
+const (
+	NumericLayout = `
+789
+456
+123
+ 0A`
+	DirectionalLayout = `
+ ^A
+<v>`
+)
+
+// Activate 是每个键盘上的激活键，机械臂开始时都指向它。
+const Activate = 'A'
+
+// moves 是方向键盘上的四个方向键及其位移，顺序决定代价相同时搜索先尝试哪个方向。
+var moves = []struct {
+	key   rune
+	delta grid.Point
+}{
+	{'^', grid.Up},
+	{'v', grid.Down},
+	{'<', grid.Left},
+	{'>', grid.Right},
+}
+
+// Keypad 是一块键盘：按键的位置和间隙。
+type Keypad struct {
+	grid *grid.Grid[rune]
+	keys map[rune]grid.Point
+}
+
+// ParseKeypad 按文本描述创建键盘。每行一排按键，空格表示间隙；
+// 首尾的空行会被忽略，较短的行在右侧补齐间隙。
+// 键盘必须包含激活键 A，同一个按键不能出现两次。
+func ParseKeypad(spec string) (*Keypad, error) {
+	lines := strings.Split(strings.Trim(strings.ReplaceAll(spec, "\r\n", "\n"), "\n"), "\n")
+	width := 0
+	for _, line := range lines {
+		width = max(width, len([]rune(line)))
+	}
+	for i, line := range lines {
+		lines[i] = line + strings.Repeat(" ", width-len([]rune(line)))
+	}
+	g, err := grid.ParseRunes(strings.Join(lines, "\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	k := &Keypad{grid: g, keys: make(map[rune]grid.Point)}
+	for p, key := range g.All() {
+		if key == ' ' {
+			continue
+		}
+		if q, dup := k.keys[key]; dup {
+			return nil, fmt.Errorf("keypad: key %q appears at %d:%d and %d:%d", key, q.Row+1, q.Col+1, p.Row+1, p.Col+1)
+		}
+		k.keys[key] = p
+	}
+	if _, ok := k.keys[Activate]; !ok {
+		return nil, fmt.Errorf("keypad: no %q key", Activate)
+	}
+	return k, nil
+}
+
+// mustKeypad 用于包内固定的布局。
+func mustKeypad(spec string) *Keypad {
+	k, err := ParseKeypad(spec)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// Has 报告键盘上是否有按键 key。
+func (k *Keypad) Has(key rune) bool {
+	_, ok := k.keys[key]
+	return ok
+}
+
+// Keys 按行优先顺序返回键盘上的所有按键。
+func (k *Keypad) Keys() []rune {
+	var keys []rune
+	for _, key := range k.grid.All() {
+		if key != ' ' {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
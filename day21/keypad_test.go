@@ -0,0 +1,22 @@
+package day21
+
+import "testing"
+
+func TestParseKeypad(t *testing.T) {
+	k, err := ParseKeypad("\n 1\n23A\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(k.Keys()), "123A"; got != want {
+		t.Errorf("Keys() = %q, want %q", got, want)
+	}
+	if k.Has(' ') {
+		t.Error("gap reported as a key")
+	}
+
+	for _, spec := range []string{"123", "1A\n2A", ""} {
+		if _, err := ParseKeypad(spec); err == nil {
+			t.Errorf("ParseKeypad(%q): want error", spec)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+package day21
+
+// Part1 返回经过两层方向键盘机器人时所有代码的复杂度之和。
+func Part1(input string) (int, error) {
+	return solve(input, 2)
+}
@@ -0,0 +1,6 @@
+package day21
+
+// Part2 返回经过 25 层方向键盘机器人时所有代码的复杂度之和。
+func Part2(input string) (int, error) {
+	return solve(input, 25)
+}
@@ -0,0 +1,72 @@
+package day21
+
+import (
+	"fmt"
+	"strings"
+
+	"adventofcode/grid"
+)
+
+// PanicError 表示重放时某条机械臂移出了键盘或停在间隙上，机器人因此恐慌。
+type PanicError struct {
+	Step  int        // 出事的是第几次人工按键，从 0 开始
+	Layer int        // 机械臂所在的键盘
+	Pos   grid.Point // 机械臂到达的位置
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("step %d: the arm over keypad %d moves to %d:%d, which is not a key", e.Step, e.Layer, e.Pos.Row+1, e.Pos.Col+1)
+}
+
+// Replay 把人在最后一块键盘上的按键 presses 依次推过整条链，返回第一块键盘上输入的字符。
+// 每条机械臂开始时指向 A。某条机械臂移出键盘或移到间隙时返回已经输入的字符和 *PanicError；
+// 人按了最后一块键盘上没有的键，或者按下了既不是方向键也不是 A 的驱动键时也返回错误。
+func (c Chain) Replay(presses string) (string, error) {
+	if err := c.check(); err != nil {
+		return "", err
+	}
+	last := len(c.Keypads) - 1
+	// arms[i] 是操作第 i 块键盘的机械臂的位置；最后一块由人按，没有机械臂。
+	arms := make([]grid.Point, last)
+	for i := range arms {
+		arms[i] = c.Keypads[i].keys[Activate]
+	}
+
+	var typed strings.Builder
+	for step, key := range []rune(presses) {
+		if !c.Keypads[last].Has(key) {
+			return typed.String(), fmt.Errorf("step %d: keypad %d has no %q key", step, last, key)
+		}
+		// 从人按的键盘往下传：方向键移动下一层的机械臂，A 让它按下所指的键。
+		for layer := last; ; layer-- {
+			if layer == 0 {
+				typed.WriteRune(key)
+				break
+			}
+			arm := &arms[layer-1]
+			if key != Activate {
+				d, ok := arrow(key)
+				if !ok {
+					return typed.String(), fmt.Errorf("step %d: key %q on keypad %d does not drive an arm", step, key, layer)
+				}
+				*arm = arm.Add(d)
+				if k, ok := c.Keypads[layer-1].grid.Get(*arm); !ok || k == ' ' {
+					return typed.String(), &PanicError{Step: step, Layer: layer - 1, Pos: *arm}
+				}
+				break
+			}
+			key = c.Keypads[layer-1].grid.At(*arm)
+		}
+	}
+	return typed.String(), nil
+}
+
+// arrow 返回方向键对应的位移；key 不是方向键时返回 false。
+func arrow(key rune) (grid.Point, bool) {
+	for _, m := range moves {
+		if m.key == key {
+			return m.delta, true
+		}
+	}
+	return grid.Point{}, false
+}
@@ -0,0 +1,90 @@
+package day21
+
+import (
+	"errors"
+	"testing"
+
+	"adventofcode/grid"
+)
+
+func TestReplay(t *testing.T) {
+	tests := []struct {
+		name    string
+		depth   int
+		presses string
+		want    string
+		wantErr *PanicError
+	}{
+		{"puzzle depth 0", 0, "<A^A>^^AvvvA", "029A", nil},
+		{"puzzle depth 1", 1, "v<<A>>^A<A>AvA<^AA>A<vAAA>^A", "029A", nil},
+		{"puzzle depth 2", 2, "<vA<AA>>^AvAA<^A>A<v<A>>^AvA^A<vA>^A<v<A>^A>AAvA^A<v<A>A>^AAAvA<^A>A", "029A", nil},
+		{"nothing pressed", 2, "", "", nil},
+		{"into the gap", 0, "<A<", "0", &PanicError{Step: 2, Layer: 0, Pos: grid.Point{Row: 3, Col: 0}}},
+		{"off the keypad", 0, "^^^^", "", &PanicError{Step: 3, Layer: 0, Pos: grid.Point{Row: -1, Col: 2}}},
+		{"robot arm into the gap", 1, "v<<A<", "", &PanicError{Step: 4, Layer: 1, Pos: grid.Point{Row: 1, Col: -1}}},
+		{"robot arm over the gap", 1, "<<", "", &PanicError{Step: 1, Layer: 1, Pos: grid.Point{Row: 0, Col: 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain, err := PuzzleChain(tt.depth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := chain.Replay(tt.presses)
+			if got != tt.want {
+				t.Errorf("Replay typed %q, want %q", got, tt.want)
+			}
+			var pe *PanicError
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("Replay: unexpected error %v", err)
+			case tt.wantErr != nil && !errors.As(err, &pe):
+				t.Errorf("Replay: got error %v, want %v", err, tt.wantErr)
+			case tt.wantErr != nil && *pe != *tt.wantErr:
+				t.Errorf("Replay: got %+v, want %+v", *pe, *tt.wantErr)
+			}
+		})
+	}
+
+	chain, err := PuzzleChain(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.Replay("A7"); err == nil {
+		t.Error("Replay of a key the human keypad does not have: want error")
+	}
+}
+
+func TestSequence(t *testing.T) {
+	codes := []string{"029A", "980A", "179A", "456A", "379A", "0A", "A"}
+	for depth := range 5 {
+		chain, err := PuzzleChain(depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, code := range codes {
+			seq, err := chain.Sequence(code, 1<<20)
+			if err != nil {
+				t.Fatalf("Sequence(%q) at depth %d: %v", code, depth, err)
+			}
+			n, err := chain.Presses(code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(seq) != n {
+				t.Errorf("depth %d: Sequence(%q) has %d presses, Presses says %d", depth, code, len(seq), n)
+			}
+			if typed, err := chain.Replay(seq); err != nil || typed != code {
+				t.Errorf("depth %d: replaying %q typed %q, %v; want %q", depth, seq, typed, err, code)
+			}
+		}
+	}
+
+	chain, err := PuzzleChain(25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.Sequence("029A", 1<<20); !errors.Is(err, ErrTooLong) {
+		t.Errorf("Sequence at depth 25: got %v, want ErrTooLong", err)
+	}
+}
@@ -0,0 +1,28 @@
+// Package day22 implements both parts of day 22 (Monkey Market).
+package day22
+
+import (
+	"io"
+
+	"adventofcode/parse"
+)
+
+// Parse reads each buyer's initial secret number from r, one per line.
+func Parse(r io.Reader) ([]int, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	var secrets []int
+	for lineNo, line := range parse.Lines(text) {
+		nums, err := parse.Ints(lineNo, line, nil)
+		switch {
+		case err != nil:
+			return nil, err
+		case len(nums) > 1:
+			return nil, parse.Errorf(lineNo, 0, "want one secret per line, got %d", len(nums))
+		}
+		secrets = append(secrets, nums...)
+	}
+	return secrets, nil
+}
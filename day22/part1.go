@@ -0,0 +1,30 @@
+package day22
+
+import (
+	"strings"
+
+	"adventofcode/secret"
+)
+
+// In a single day, buyers generate 2000 new secret numbers
+const iterations = 2000
+
+// Part1 returns the sum of each buyer's 2000th secret number.
+func Part1(input string) (int, error) {
+	secrets, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return solvePart1(secrets), nil
+}
+
+// solvePart1 returns the sum of the 2000th secret numbers of all buyers. Every
+// buyer jumps ahead with the same matrix instead of stepping 2000 times.
+func solvePart1(secrets []int) int {
+	jump := secret.Jump(iterations)
+	totalSum := 0
+	for _, initialSecret := range secrets {
+		totalSum += int(jump.Apply(uint32(initialSecret)))
+	}
+	return totalSum
+}
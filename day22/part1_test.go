@@ -0,0 +1,47 @@
+package day22
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSolve uses a table-driven test to check the main solving logic with the puzzle's example.
+func TestSolvePart1(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name: "Puzzle Example",
+			input: `
+1
+10
+100
+2024
+`,
+			want: 37327623,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Trim leading/trailing whitespace for cleaner test cases.
+			input := strings.TrimSpace(tt.input)
+			secrets, err := Parse(strings.NewReader(input))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := solvePart1(secrets); got != tt.want {
+				t.Errorf("solvePart1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	_, err := Parse(strings.NewReader("1\n10\n1x0\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "line 3, column 1:") {
+		t.Errorf("Parse() error = %v, want line 3, column 1", err)
+	}
+}
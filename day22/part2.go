@@ -0,0 +1,206 @@
+package day22
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"runtime"
+	"strings"
+	"sync"
+
+	"adventofcode/secret"
+)
+
+const (
+	numNewSecrets   = 2000 // Each buyer generates 2000 new secrets
+	changeSeqLength = 4    // The monkey looks for a sequence of 4 changes
+
+	// A price change is in -9..9, so a window of four changes is a base-19
+	// number with four digits and indexes a flat array of windowCount slots.
+	changeBase  = 19
+	windowCount = changeBase * changeBase * changeBase * changeBase
+
+	// buyersPerJob is how many buyers a worker takes from the queue at a time.
+	buyersPerJob = 64
+)
+
+// Part2 returns the most bananas obtainable with a single sequence of four
+// price changes.
+func Part2(input string) (int, error) {
+	secrets, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return solvePart2(secrets), nil
+}
+
+// solvePart2 returns the most bananas obtainable, using one worker per CPU.
+// With no buyers there are no bananas, so ErrNoBuyers is not an error here.
+func solvePart2(secrets []int) int {
+	best, _ := BestSequence(secrets, runtime.GOMAXPROCS(0))
+	return best.Bananas
+}
+
+// Sequence is a window of four consecutive price changes.
+type Sequence [changeSeqLength]int
+
+// String formats s the way the puzzle does, e.g. "-2,1,-1,3".
+func (s Sequence) String() string {
+	parts := make([]string, len(s))
+	for i, c := range s {
+		parts[i] = fmt.Sprint(c)
+	}
+	return strings.Join(parts, ",")
+}
+
+// index returns the slot of s in a scoreboard.
+func (s Sequence) index() int {
+	idx := 0
+	for _, c := range s {
+		idx = idx*changeBase + c + 9
+	}
+	return idx
+}
+
+// sequenceAt is the inverse of Sequence.index.
+func sequenceAt(idx int) Sequence {
+	var s Sequence
+	for i := len(s) - 1; i >= 0; i-- {
+		s[i] = idx%changeBase - 9
+		idx /= changeBase
+	}
+	return s
+}
+
+// Sale is what one buyer does when the monkey watches for a sequence.
+type Sale struct {
+	Buyer  int // index of the buyer in the input
+	Secret int // the buyer's initial secret number
+	// Step is the number of the secret at which the buyer sells, counting the
+	// initial secret as 0, or 0 if the sequence never occurs for this buyer.
+	Step  int
+	Price int // bananas received, 0 if the buyer never sells
+}
+
+// Best describes the winning change sequence.
+type Best struct {
+	Sequence Sequence
+	Bananas  int
+	// Sales has one entry per buyer, in input order.
+	Sales []Sale
+}
+
+// ErrNoBuyers is returned by BestSequence when there are no buyers, so no
+// sequence of changes ever occurs.
+var ErrNoBuyers = errors.New("no buyers")
+
+// BestSequence finds the change sequence that earns the most bananas across
+// all buyers, splitting the buyers among the given number of workers. Ties go
+// to the sequence with the smallest changes, so the result does not depend on
+// the number of workers. It returns a zero Best and ErrNoBuyers if secrets is
+// empty.
+func BestSequence(secrets []int, workers int) (Best, error) {
+	if len(secrets) == 0 {
+		return Best{}, ErrNoBuyers
+	}
+	board := scoreboard(secrets, workers)
+	best := 0
+	for idx, bananas := range board {
+		if bananas > board[best] {
+			best = idx
+		}
+	}
+	return Best{
+		Sequence: sequenceAt(best),
+		Bananas:  board[best],
+		Sales:    sales(secrets, best),
+	}, nil
+}
+
+// scoreboard returns, for every window index, the bananas earned by selling at
+// its first occurrence for each buyer. Workers pull batches of buyers from a
+// queue, fill their own boards and the boards are summed at the end.
+func scoreboard(secrets []int, workers int) []int {
+	workers = max(1, min(workers, (len(secrets)+buyersPerJob-1)/buyersPerJob))
+	jobs := make(chan []int)
+	boards := make([][]int, workers)
+	var wg sync.WaitGroup
+	for w := range boards {
+		boards[w] = make([]int, windowCount)
+		wg.Add(1)
+		go func(board []int) {
+			defer wg.Done()
+			// seen[idx] holds the generation of the last buyer in which the
+			// window occurred; bumping the generation clears it for the next
+			// buyer without touching the array.
+			seen := make([]int32, windowCount)
+			var generation int32
+			for batch := range jobs {
+				for _, initial := range batch {
+					generation++
+					scoreBuyer(initial, board, seen, generation)
+				}
+			}
+		}(boards[w])
+	}
+	for start := 0; start < len(secrets); start += buyersPerJob {
+		jobs <- secrets[start:min(start+buyersPerJob, len(secrets))]
+	}
+	close(jobs)
+	wg.Wait()
+
+	total := boards[0]
+	for _, board := range boards[1:] {
+		for idx, bananas := range board {
+			total[idx] += bananas
+		}
+	}
+	return total
+}
+
+// scoreBuyer adds one buyer's first sale for every window to board.
+func scoreBuyer(initial int, board []int, seen []int32, generation int32) {
+	for _, w := range windows(initial) {
+		if seen[w.idx] != generation {
+			seen[w.idx] = generation
+			board[w.idx] += w.price
+		}
+	}
+}
+
+// window is the index of four consecutive changes and the price after them.
+type window struct {
+	idx, price int
+}
+
+// windows yields, for each secret from the fourth change on, its step and the
+// window of changes that ends there.
+func windows(s int) iter.Seq2[int, window] {
+	return func(yield func(int, window) bool) {
+		price, idx := s%10, 0
+		for step := 1; step <= numNewSecrets; step++ {
+			s = secret.Next(s)
+			next := s % 10
+			idx = (idx*changeBase + next - price + 9) % windowCount
+			price = next
+			if step >= changeSeqLength && !yield(step, window{idx, price}) {
+				return
+			}
+		}
+	}
+}
+
+// sales replays every buyer to find where they sell for the window idx.
+func sales(secrets []int, idx int) []Sale {
+	out := make([]Sale, len(secrets))
+	for buyer, initial := range secrets {
+		out[buyer] = Sale{Buyer: buyer, Secret: initial}
+		for step, w := range windows(initial) {
+			if w.idx == idx {
+				out[buyer].Step, out[buyer].Price = step, w.price
+				break
+			}
+		}
+	}
+	return out
+}
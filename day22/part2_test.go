@@ -0,0 +1,143 @@
+package day22
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"adventofcode/secret"
+)
+
+func TestSolvePart2(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name: "Part 2 Puzzle Example",
+			input: `
+1
+2
+3
+2024
+`,
+			want: 23,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := strings.TrimSpace(tt.input)
+			secrets, err := Parse(strings.NewReader(input))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := solvePart2(secrets); got != tt.want {
+				t.Errorf("solvePart2() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBestSequence(t *testing.T) {
+	best, err := BestSequence([]int{1, 2, 3, 2024}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := best.Sequence.String(), "-2,1,-1,3"; got != want {
+		t.Errorf("Sequence = %s, want %s", got, want)
+	}
+	if best.Bananas != 23 {
+		t.Errorf("Bananas = %d, want 23", best.Bananas)
+	}
+	// The puzzle's breakdown: buyers 1, 2 and 2024 sell for 7, 7 and 9 bananas,
+	// buyer 3 never sees the sequence.
+	wantPrices := []int{7, 7, 0, 9}
+	for i, s := range best.Sales {
+		if s.Buyer != i || s.Price != wantPrices[i] || (s.Step == 0) != (wantPrices[i] == 0) {
+			t.Errorf("Sales[%d] = %+v, want price %d", i, s, wantPrices[i])
+		}
+	}
+	if sum := best.Sales[0].Price + best.Sales[1].Price + best.Sales[3].Price; sum != best.Bananas {
+		t.Errorf("sales add up to %d, want %d", sum, best.Bananas)
+	}
+
+	// Without buyers no sequence occurs at all, rather than -9,-9,-9,-9 winning
+	// with 0 bananas.
+	if best, err := BestSequence(nil, 2); !errors.Is(err, ErrNoBuyers) || best.Bananas != 0 || best.Sales != nil {
+		t.Errorf("BestSequence(nil) = %+v, %v, want ErrNoBuyers", best, err)
+	}
+	if got := solvePart2(nil); got != 0 {
+		t.Errorf("solvePart2(nil) = %d, want 0", got)
+	}
+}
+
+// referenceScores is the straightforward map-based scoreboard.
+func referenceScores(secrets []int) map[Sequence]int {
+	scores := make(map[Sequence]int)
+	for _, s := range secrets {
+		prices := []int{s % 10}
+		for range numNewSecrets {
+			s = secret.Next(s)
+			prices = append(prices, s%10)
+		}
+		seen := make(map[Sequence]bool)
+		for i := changeSeqLength; i < len(prices); i++ {
+			var seq Sequence
+			for j := range seq {
+				seq[j] = prices[i-changeSeqLength+j+1] - prices[i-changeSeqLength+j]
+			}
+			if !seen[seq] {
+				seen[seq] = true
+				scores[seq] += prices[i]
+			}
+		}
+	}
+	return scores
+}
+
+func TestScoreboardMatchesReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(22, 2))
+	secrets := make([]int, 300)
+	for i := range secrets {
+		secrets[i] = rng.IntN(secret.Modulus)
+	}
+	want := referenceScores(secrets)
+	wantBest := 0
+	for _, v := range want {
+		wantBest = max(wantBest, v)
+	}
+
+	var first Best
+	for _, workers := range []int{1, 3, 8, 100} {
+		board := scoreboard(secrets, workers)
+		for seq, v := range want {
+			if got := board[seq.index()]; got != v {
+				t.Fatalf("workers=%d: board[%v] = %d, want %d", workers, seq, got, v)
+			}
+		}
+		best, err := BestSequence(secrets, workers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if best.Bananas != wantBest || want[best.Sequence] != wantBest {
+			t.Errorf("workers=%d: best %v with %d bananas, want %d", workers, best.Sequence, best.Bananas, wantBest)
+		}
+		if workers == 1 {
+			first = best
+		} else if best.Sequence != first.Sequence {
+			t.Errorf("workers=%d: best sequence %v differs from %v with one worker", workers, best.Sequence, first.Sequence)
+		}
+	}
+}
+
+func TestSequenceIndex(t *testing.T) {
+	for _, seq := range []Sequence{{-9, -9, -9, -9}, {9, 9, 9, 9}, {-2, 1, -1, 3}, {0, 0, 0, 0}} {
+		idx := seq.index()
+		if idx < 0 || idx >= windowCount || sequenceAt(idx) != seq {
+			t.Errorf("index(%v) = %d, sequenceAt gives %v", seq, idx, sequenceAt(idx))
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Package day23 实现第 23 天（LAN Party）的两部分求解。
+package day23
+
+import (
+	"io"
+	"strings"
+
+	"adventofcode/netgraph"
+	"adventofcode/parse"
+)
+
+// Connection 是两台计算机之间的一条（双向）网络连接。
+type Connection struct {
+	A, B string
+}
+
+// Parse 从 r 读取网络连接图，每行一条形如 "kh-tc" 的连接，忽略空行。
+func Parse(r io.Reader) ([]Connection, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	var conns []Connection
+	for lineNo, line := range parse.Lines(text) {
+		fields := parse.Fields(line, nil)
+		switch {
+		case len(fields) == 0:
+			continue
+		case len(fields) > 1:
+			return nil, parse.Errorf(lineNo, fields[1].Col, "want one connection per line")
+		}
+		f := fields[0]
+		a, b, ok := strings.Cut(f.Text, "-")
+		switch {
+		case !ok:
+			return nil, parse.Errorf(lineNo, f.Col, "connection %q has no '-'", f.Text)
+		case a == "":
+			return nil, parse.Errorf(lineNo, f.Col, "missing computer name before '-'")
+		case b == "" || strings.Contains(b, "-"):
+			return nil, parse.Errorf(lineNo, f.Col+parse.Col(f.Text, len(a)), "want one computer name after '-'")
+		}
+		conns = append(conns, Connection{A: a, B: b})
+	}
+	return conns, nil
+}
+
+// Network 用连接列表建立网络图。
+func Network(conns []Connection) *netgraph.Graph {
+	edges := make([][2]string, len(conns))
+	for i, c := range conns {
+		edges[i] = [2]string{c.A, c.B}
+	}
+	return netgraph.New(edges)
+}
@@ -0,0 +1,30 @@
+package day23
+
+import "strings"
+
+// Part1 返回至少包含一台名字以 t 开头的计算机的三台互连计算机组数量。
+func Part1(input string) (int, error) {
+	conns, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return solvePart1(conns), nil
+}
+
+// solvePart1 枚举所有三元团，统计其中含有 t 开头计算机的个数。
+func solvePart1(conns []Connection) int {
+	g := Network(conns)
+	hasT := func(clique []int) bool {
+		for _, name := range g.Names(clique) {
+			if strings.HasPrefix(name, "t") {
+				return true
+			}
+		}
+		return false
+	}
+	count := 0
+	for range g.Cliques(3, hasT) {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,84 @@
+package day23
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSolvePart1(t *testing.T) {
+	// 谜题中提供的示例输入
+	exampleInput := `
+kh-tc
+qp-kh
+de-cg
+ka-co
+yn-aq
+qp-ub
+cg-tb
+vc-aq
+tb-ka
+wh-tc
+yn-cg
+kh-ub
+ta-co
+de-co
+tc-td
+tb-wq
+wh-td
+ta-ka
+td-qp
+aq-cg
+wq-ub
+ub-vc
+de-ta
+wq-aq
+wq-vc
+wh-yn
+ka-de
+kh-ta
+co-tc
+wh-qp
+tb-vc
+td-yn
+`
+	// 使用 Table-Driven Tests
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name:  "example",
+			input: strings.TrimSpace(exampleInput),
+			want:  7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conns, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := solvePart1(conns); got != tt.want {
+				t.Errorf("solvePart1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"kh-tc\nqpkh\n", "line 2, column 1: connection \"qpkh\" has no '-'"},
+		{"kh-tc\n  qp-\n", "line 2, column 6: want one computer name after '-'"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %q", tt.input, err, tt.want)
+		}
+	}
+}
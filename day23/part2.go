@@ -0,0 +1,23 @@
+package day23
+
+import (
+	"slices"
+	"strings"
+)
+
+// Part2 返回局域网派对的密码，即最大团中计算机名按字母排序后用逗号连接。
+func Part2(input string) (string, error) {
+	conns, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return "", err
+	}
+	return solvePart2(conns), nil
+}
+
+// solvePart2 求最大团，把其中的计算机名排序后连接。
+func solvePart2(conns []Connection) string {
+	g := Network(conns)
+	names := g.Names(g.MaxClique())
+	slices.Sort(names)
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,80 @@
+package day23
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSolvePart2(t *testing.T) {
+	// 谜题中提供的示例输入
+	exampleInput := `
+kh-tc
+qp-kh
+de-cg
+ka-co
+yn-aq
+qp-ub
+cg-tb
+vc-aq
+tb-ka
+wh-tc
+yn-cg
+kh-ub
+ta-co
+de-co
+tc-td
+tb-wq
+wh-td
+ta-ka
+td-qp
+aq-cg
+wq-ub
+ub-vc
+de-ta
+wq-aq
+wq-vc
+wh-yn
+ka-de
+kh-ta
+co-tc
+wh-qp
+tb-vc
+td-yn
+`
+	// 使用 Table-Driven Tests
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "example",
+			input: strings.TrimSpace(exampleInput),
+			want:  "co,de,ka,ta",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conns, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := solvePart2(conns); got != tt.want {
+				t.Errorf("solvePart2() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// 最大团在搜索分支中不按编号顺序组成时，答案仍然要按名字排序。
+func TestPart2Sorted(t *testing.T) {
+	input := "aa-bb\naa-cc\naa-ee\naa-ff\ncc-dd\ncc-ff\ndd-ee\nee-ff\n"
+	got, err := Part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "aa,cc,ff" && got != "aa,ee,ff" {
+		t.Errorf("Part2() = %q, want aa,cc,ff or aa,ee,ff", got)
+	}
+}
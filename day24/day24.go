@@ -0,0 +1,83 @@
+// Package day24 实现第 24 天（Crossed Wires）的两部分求解。
+package day24
+
+import (
+	"io"
+	"strings"
+
+	"adventofcode/circuit"
+	"adventofcode/parse"
+)
+
+// Circuit 是解析后的谜题输入：输入导线的初始值和所有逻辑门。
+type Circuit struct {
+	Wires map[string]int
+	Gates []circuit.Gate
+}
+
+// Parse 从 r 读取电路：第一段是 "x00: 1" 形式的初始导线值，
+// 空行之后是 "x00 AND y00 -> z00" 形式的逻辑门，每行一个。
+func Parse(r io.Reader) (*Circuit, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	c := &Circuit{Wires: make(map[string]int)}
+	section := 0
+	for start, block := range parse.Blocks(text) {
+		section++
+		for i, line := range strings.Split(block, "\n") {
+			lineNo := start + i
+			switch section {
+			case 1:
+				err = c.parseWire(lineNo, line)
+			case 2:
+				err = c.parseGate(lineNo, line)
+			default:
+				err = parse.Errorf(lineNo, 0, "unexpected section after the gates")
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if section < 2 {
+		return nil, parse.Errorf(strings.Count(text, "\n")+1, 0, "missing gate section")
+	}
+	return c, nil
+}
+
+// parseWire 解析一行 "x00: 1"。
+func (c *Circuit) parseWire(lineNo int, line string) error {
+	fields := parse.Fields(line, nil)
+	if len(fields) != 2 || !strings.HasSuffix(fields[0].Text, ":") {
+		return parse.Errorf(lineNo, 0, "want \"wire: value\", got %q", line)
+	}
+	name := strings.TrimSuffix(fields[0].Text, ":")
+	if _, dup := c.Wires[name]; dup {
+		return parse.Errorf(lineNo, fields[0].Col, "wire %s is set twice", name)
+	}
+	v := fields[1]
+	if v.Text != "0" && v.Text != "1" {
+		return parse.Errorf(lineNo, v.Col, "invalid wire value %q, want 0 or 1", v.Text)
+	}
+	c.Wires[name] = int(v.Text[0] - '0')
+	return nil
+}
+
+// parseGate 解析一行 "a OP b -> c"。
+func (c *Circuit) parseGate(lineNo int, line string) error {
+	fields := parse.Fields(line, nil)
+	if len(fields) != 5 {
+		return parse.Errorf(lineNo, 0, "want \"a OP b -> c\", got %q", line)
+	}
+	op, ok := circuit.ParseOp(fields[1].Text)
+	if !ok {
+		return parse.Errorf(lineNo, fields[1].Col, "unknown gate %q", fields[1].Text)
+	}
+	if arrow := fields[3]; arrow.Text != "->" {
+		return parse.Errorf(lineNo, arrow.Col, "want \"->\", got %q", arrow.Text)
+	}
+	c.Gates = append(c.Gates, circuit.Gate{A: fields[0].Text, B: fields[2].Text, Op: op, Out: fields[4].Text})
+	return nil
+}
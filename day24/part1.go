@@ -0,0 +1,53 @@
+package day24
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"adventofcode/circuit"
+)
+
+// Part1 返回模拟电路后 z 导线组成的十进制数。
+func Part1(input string) (*big.Int, error) {
+	c, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	return solvePart1(c)
+}
+
+// solvePart1 按初始导线值模拟电路，返回 z 总线的值。没有门读取的初始导线
+// 不影响任何门，但其中的 z 导线仍然是输出的一位。
+func solvePart1(c *Circuit) (*big.Int, error) {
+	sim, err := circuit.New(c.Gates)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool)
+	for _, wire := range sim.Wires() {
+		known[wire] = true
+	}
+	s := sim.NewState()
+	var unused []string
+	for wire, v := range c.Wires {
+		if !known[wire] {
+			unused = append(unused, wire)
+			continue
+		}
+		if err := s.Set(wire, v == 1); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.Run(); err != nil {
+		return nil, err
+	}
+	z := s.Bus("z")
+	for _, wire := range unused {
+		digits, ok := strings.CutPrefix(wire, "z")
+		if bit, err := strconv.Atoi(digits); ok && err == nil && bit >= 0 && c.Wires[wire] == 1 {
+			z.SetBit(z, bit, 1)
+		}
+	}
+	return z, nil
+}
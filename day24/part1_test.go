@@ -1,10 +1,11 @@
-package main
+package day24
 
 import (
+	"strings"
 	"testing"
 )
 
-func TestSolve(t *testing.T) {
+func TestSolvePart1(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
@@ -75,13 +76,49 @@ tgd XOR rvg -> z12
 tnw OR pbm -> gnj`,
 			want: 2024, // 二进制 0011111101000
 		},
+		{
+			name: "initial values no gate reads",
+			input: `x00: 1
+y00: 1
+w07: 1
+z05: 1
+
+x00 AND y00 -> z00`,
+			want: 33, // 二进制 100001：w07 被忽略，z05 仍是输出的一位
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := solve(tt.input); got != tt.want {
-				t.Errorf("solve() = %v, want %v", got, tt.want)
+			c, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got, err := solvePart1(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Int64() != tt.want {
+				t.Errorf("solvePart1() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"x00: 1\ny00: 2\n\nx00 AND y00 -> z00\n", "line 2, column 6: invalid wire value \"2\", want 0 or 1"},
+		{"x00: 1\n\nx00 NAND y00 -> z00\n", "line 3, column 5: unknown gate \"NAND\""},
+		{"x00: 1\n\nx00 AND y00 => z00\n", "line 3, column 13: want \"->\", got \"=>\""},
+		{"x00: 1\ny00: 0\n", "line 3: missing gate section"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %q", tt.input, err, tt.want)
+		}
+	}
+}
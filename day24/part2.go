@@ -0,0 +1,38 @@
+package day24
+
+import (
+	"errors"
+	"strings"
+
+	"adventofcode/circuit"
+)
+
+// maxSwaps 是谜题中交换过的输出对数。
+const maxSwaps = 4
+
+// Part2 返回需要交换的八根导线，排序后用逗号连接。
+func Part2(input string) (string, error) {
+	r, err := RepairPart2(input)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(r.Wires(), ","), nil
+}
+
+// RepairPart2 把输入中的电路修复为加法器，位宽取 x 总线的宽度。
+// 返回的修复已经用模拟验证过，包含交换的导线对和验证报告所需的信息。
+func RepairPart2(input string) (*circuit.Repair, error) {
+	c, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	sim, err := circuit.New(c.Gates)
+	if err != nil {
+		return nil, err
+	}
+	width := sim.Width("x")
+	if width == 0 {
+		return nil, errors.New("circuit has no x inputs")
+	}
+	return circuit.RepairAdder(c.Gates, width, maxSwaps)
+}
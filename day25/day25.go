@@ -0,0 +1,65 @@
+// Package day25 implements day 25 (Code Chronicle), which only has one part.
+package day25
+
+import (
+	"io"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// totalHeight is the number of pin rows between a schematic's top and bottom rows.
+const totalHeight = 5
+
+// Schematics holds the pin heights of every lock and key in the input.
+type Schematics struct {
+	Locks, Keys [][]int
+}
+
+// Parse reads the lock and key schematics from r. Schematics are separated
+// by blank lines; a lock has its top row filled with '#', a key its bottom row.
+func Parse(r io.Reader) (*Schematics, error) {
+	text, err := parse.Text(r)
+	if err != nil {
+		return nil, err
+	}
+	s := &Schematics{}
+	for start, block := range parse.Blocks(text) {
+		lines := strings.Split(block, "\n")
+		if len(lines) != totalHeight+2 {
+			return nil, parse.Errorf(start, 0, "schematic has %d rows, want %d", len(lines), totalHeight+2)
+		}
+		width := len(lines[0])
+		for i, line := range lines {
+			if j := strings.IndexFunc(line, func(r rune) bool { return r != '#' && r != '.' }); j >= 0 {
+				return nil, parse.Errorf(start+i, parse.Col(line, j), "invalid character %q", line[j:j+1])
+			}
+			if len(line) != width {
+				return nil, parse.Errorf(start+i, 0, "row has length %d, want %d", len(line), width)
+			}
+		}
+
+		first, last := lines[0], lines[len(lines)-1]
+		isLock := !strings.Contains(first, ".") && !strings.Contains(last, "#")
+		isKey := !strings.Contains(first, "#") && !strings.Contains(last, ".")
+		if !isLock && !isKey {
+			return nil, parse.Errorf(start, 0, "schematic is neither a lock nor a key")
+		}
+
+		// The height is the number of '#' in the middle rows for each column.
+		heights := make([]int, width)
+		for col := range heights {
+			for _, row := range lines[1 : totalHeight+1] {
+				if row[col] == '#' {
+					heights[col]++
+				}
+			}
+		}
+		if isLock {
+			s.Locks = append(s.Locks, heights)
+		} else {
+			s.Keys = append(s.Keys, heights)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,43 @@
+package day25
+
+import "strings"
+
+// Part1 returns the number of unique lock/key pairs that fit together
+// without overlapping in any column.
+func Part1(input string) (int, error) {
+	s, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return 0, err
+	}
+	return solve(s), nil
+}
+
+// solve counts the lock/key pairs that fit together.
+func solve(s *Schematics) int {
+	locks, keys := s.Locks, s.Keys
+
+	// Count fitting pairs
+	fitCount := 0
+	for _, lock := range locks {
+		for _, key := range keys {
+			if checkFit(lock, key, totalHeight) {
+				fitCount++
+			}
+		}
+	}
+
+	return fitCount
+}
+
+// checkFit determines if a key fits into a lock.
+func checkFit(lock, key []int, totalHeight int) bool {
+	if len(lock) != len(key) {
+		return false // Should not happen with valid input
+	}
+	for i := 0; i < len(lock); i++ {
+		if lock[i]+key[i] > totalHeight {
+			return false // Overlap
+		}
+	}
+	return true // It fits
+}
@@ -1,4 +1,4 @@
-package main
+package day25
 
 import (
 	"strings"
@@ -64,10 +64,31 @@ func TestSolve(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := solve(tt.input)
+			s, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got := solve(s)
 			if got != tt.want {
 				t.Errorf("solve() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"#####\n.####\n.####\n.####\n.#.#.\n.#...\n", "line 1: schematic has 6 rows, want 7"},
+		{"#####\n.####\n.####\n.##x#\n.#.#.\n.#...\n.....\n", "line 4, column 4: invalid character \"x\""},
+		{"#####\n.####\n.####\n.####\n.#.#.\n.#...\n#####\n", "line 1: schematic is neither a lock nor a key"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %q", tt.input, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// Package grid 提供各天共用的二维网格：坐标、边界检查、邻居遍历、
+// 从文本解析、查找标记字符、克隆、旋转/转置以及打印。
+//
+// 坐标统一使用 (Row, Col)，行向下递增、列向右递增。
+package grid
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// Point 是网格中的一个坐标。
+type Point struct {
+	Row, Col int
+}
+
+// Add 返回 p 沿 d 移动一次后的坐标。
+func (p Point) Add(d Point) Point {
+	return Point{p.Row + d.Row, p.Col + d.Col}
+}
+
+// Sub 返回从 q 指向 p 的位移。
+func (p Point) Sub(q Point) Point {
+	return Point{p.Row - q.Row, p.Col - q.Col}
+}
+
+// Scale 返回 p 的 k 倍。
+func (p Point) Scale(k int) Point {
+	return Point{p.Row * k, p.Col * k}
+}
+
+// Manhattan 返回 p 与 q 之间的曼哈顿距离。
+func (p Point) Manhattan(q Point) int {
+	return abs(p.Row-q.Row) + abs(p.Col-q.Col)
+}
+
+// 四个基本方向。
+var (
+	Up    = Point{-1, 0}
+	Right = Point{0, 1}
+	Down  = Point{1, 0}
+	Left  = Point{0, -1}
+)
+
+// Dirs4 按顺时针顺序（上、右、下、左）列出四个正交方向。
+var Dirs4 = [4]Point{Up, Right, Down, Left}
+
+// Dirs8 按顺时针顺序列出包括对角线在内的八个方向，从上开始。
+var Dirs8 = [8]Point{
+	Up, {-1, 1}, Right, {1, 1}, Down, {1, -1}, Left, {-1, -1},
+}
+
+// Grid 是按行存储的矩形网格。
+type Grid[T any] struct {
+	Width, Height int
+	cells         []T
+}
+
+// New 创建一个宽 width、高 height、所有单元格为零值的网格。
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{Width: width, Height: height, cells: make([]T, width*height)}
+}
+
+// Parse 把多行文本解析为网格，每个字符经 conv 转换为单元格的值。
+// 输入首尾的空行会被忽略；各行长度不一致时返回错误。
+func Parse[T any](text string, conv func(r rune) T) (*Grid[T], error) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	trimmed := strings.TrimLeft(text, "\n")
+	skipped := len(text) - len(trimmed) // 被忽略的开头空行数，用于报告原始行号
+	text = strings.TrimRight(trimmed, "\n")
+	if text == "" {
+		return New[T](0, 0), nil
+	}
+	lines := strings.Split(text, "\n")
+
+	width := len([]rune(lines[0]))
+	g := New[T](width, len(lines))
+	for row, line := range lines {
+		runes := []rune(line)
+		if len(runes) != width {
+			return nil, parse.Errorf(skipped+row+1, min(len(runes), width)+1,
+				"grid: line has length %d, want %d", len(runes), width)
+		}
+		for col, r := range runes {
+			g.cells[row*width+col] = conv(r)
+		}
+	}
+	return g, nil
+}
+
+// ParseRunes 把多行文本解析为字符网格。
+func ParseRunes(text string) (*Grid[rune], error) {
+	return Parse(text, func(r rune) rune { return r })
+}
+
+// ParseDigits 把由数字字符组成的多行文本解析为整数网格，非数字字符记为 -1。
+func ParseDigits(text string) (*Grid[int], error) {
+	return Parse(text, func(r rune) int {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return int(r - '0')
+	})
+}
+
+// InBounds 判断 p 是否在网格范围内。
+func (g *Grid[T]) InBounds(p Point) bool {
+	return p.Row >= 0 && p.Row < g.Height && p.Col >= 0 && p.Col < g.Width
+}
+
+// At 返回 p 处的值；p 越界时 panic。
+func (g *Grid[T]) At(p Point) T {
+	if !g.InBounds(p) {
+		panic(fmt.Sprintf("grid: %v out of bounds %dx%d", p, g.Width, g.Height))
+	}
+	return g.cells[p.Row*g.Width+p.Col]
+}
+
+// Get 返回 p 处的值；p 越界时返回零值和 false。
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[p.Row*g.Width+p.Col], true
+}
+
+// Set 设置 p 处的值；p 越界时 panic。
+func (g *Grid[T]) Set(p Point, v T) {
+	if !g.InBounds(p) {
+		panic(fmt.Sprintf("grid: %v out of bounds %dx%d", p, g.Width, g.Height))
+	}
+	g.cells[p.Row*g.Width+p.Col] = v
+}
+
+// Fill 把所有单元格设为 v。
+func (g *Grid[T]) Fill(v T) {
+	for i := range g.cells {
+		g.cells[i] = v
+	}
+}
+
+// All 按行优先顺序遍历所有单元格。
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{i / g.Width, i % g.Width}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors4 遍历 p 在网格内的四个正交邻居，顺序同 Dirs4。
+func (g *Grid[T]) Neighbors4(p Point) iter.Seq[Point] {
+	return g.neighbors(p, Dirs4[:])
+}
+
+// Neighbors8 遍历 p 在网格内的八个邻居（含对角线），顺序同 Dirs8。
+func (g *Grid[T]) Neighbors8(p Point) iter.Seq[Point] {
+	return g.neighbors(p, Dirs8[:])
+}
+
+func (g *Grid[T]) neighbors(p Point, dirs []Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, d := range dirs {
+			if n := p.Add(d); g.InBounds(n) && !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// Find 返回第一个（按行优先）满足 match 的单元格坐标。
+func (g *Grid[T]) Find(match func(T) bool) (Point, bool) {
+	for p, v := range g.All() {
+		if match(v) {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// Locate 返回第一个等于 marker 的单元格坐标，用于查找 S、E、@、^ 等标记。
+func Locate[T comparable](g *Grid[T], marker T) (Point, bool) {
+	return g.Find(func(v T) bool { return v == marker })
+}
+
+// LocateAll 按行优先顺序返回所有等于 marker 的单元格坐标。
+func LocateAll[T comparable](g *Grid[T], marker T) []Point {
+	var points []Point
+	for p, v := range g.All() {
+		if v == marker {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Clone 返回网格的深拷贝。
+func (g *Grid[T]) Clone() *Grid[T] {
+	c := &Grid[T]{Width: g.Width, Height: g.Height, cells: make([]T, len(g.cells))}
+	copy(c.cells, g.cells)
+	return c
+}
+
+// Transpose 返回行列互换后的新网格。
+func (g *Grid[T]) Transpose() *Grid[T] {
+	t := New[T](g.Height, g.Width)
+	for p, v := range g.All() {
+		t.Set(Point{p.Col, p.Row}, v)
+	}
+	return t
+}
+
+// RotateCW 返回顺时针旋转 90 度后的新网格。
+func (g *Grid[T]) RotateCW() *Grid[T] {
+	r := New[T](g.Height, g.Width)
+	for p, v := range g.All() {
+		r.Set(Point{p.Col, g.Height - 1 - p.Row}, v)
+	}
+	return r
+}
+
+// RotateCCW 返回逆时针旋转 90 度后的新网格。
+func (g *Grid[T]) RotateCCW() *Grid[T] {
+	r := New[T](g.Height, g.Width)
+	for p, v := range g.All() {
+		r.Set(Point{g.Width - 1 - p.Col, p.Row}, v)
+	}
+	return r
+}
+
+// Format 把网格逐行格式化为文本，每个单元格由 cell 转换为一个字符。
+func (g *Grid[T]) Format(cell func(p Point, v T) rune) string {
+	var sb strings.Builder
+	sb.Grow((g.Width + 1) * g.Height)
+	for row := 0; row < g.Height; row++ {
+		for col := 0; col < g.Width; col++ {
+			p := Point{row, col}
+			sb.WriteRune(cell(p, g.At(p)))
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// String 打印网格。rune 网格按原字符输出，其他类型的单元格取 %v 格式化结果的首字符。
+func (g *Grid[T]) String() string {
+	return g.Format(func(_ Point, v T) rune {
+		if r, ok := any(v).(rune); ok {
+			return r
+		}
+		s := fmt.Sprint(v)
+		if s == "" {
+			return ' '
+		}
+		return []rune(s)[0]
+	})
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,236 @@
+package grid
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"adventofcode/parse"
+)
+
+const sample = `#.S
+.@.
+^.E
+`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantWidth  int
+		wantHeight int
+		wantErr    bool
+	}{
+		{name: "square", input: sample, wantWidth: 3, wantHeight: 3},
+		{name: "crlf", input: "ab\r\ncd\r\n", wantWidth: 2, wantHeight: 2},
+		{name: "surrounding blank lines", input: "\n\nabc\n\n", wantWidth: 3, wantHeight: 1},
+		{name: "empty", input: "", wantWidth: 0, wantHeight: 0},
+		{name: "ragged", input: "abc\nab\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ParseRunes(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if g.Width != tt.wantWidth || g.Height != tt.wantHeight {
+				t.Errorf("size = %dx%d, want %dx%d", g.Width, g.Height, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestParseErrorPosition(t *testing.T) {
+	_, err := ParseRunes("\nabc\nabc\nab\n")
+	var pe *parse.Error
+	if !errors.As(err, &pe) {
+		t.Fatalf("err = %v, want *parse.Error", err)
+	}
+	if pe.Line != 4 || pe.Col != 3 {
+		t.Errorf("position = %d:%d, want 4:3", pe.Line, pe.Col)
+	}
+}
+
+func TestParseDigits(t *testing.T) {
+	g, err := ParseDigits("09\n.5\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{0, 9, -1, 5}
+	var got []int
+	for _, v := range g.All() {
+		got = append(got, v)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("cells = %v, want %v", got, want)
+	}
+}
+
+func TestLocate(t *testing.T) {
+	g, err := ParseRunes(sample)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		marker rune
+		want   Point
+		found  bool
+	}{
+		{'S', Point{0, 2}, true},
+		{'E', Point{2, 2}, true},
+		{'@', Point{1, 1}, true},
+		{'^', Point{2, 0}, true},
+		{'X', Point{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.marker), func(t *testing.T) {
+			got, ok := Locate(g, tt.marker)
+			if ok != tt.found || got != tt.want {
+				t.Errorf("Locate(%q) = %v, %v; want %v, %v", tt.marker, got, ok, tt.want, tt.found)
+			}
+		})
+	}
+
+	if got, want := LocateAll(g, '.'), []Point{{0, 1}, {1, 0}, {1, 2}, {2, 1}}; !slices.Equal(got, want) {
+		t.Errorf("LocateAll('.') = %v, want %v", got, want)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[int](3, 3)
+
+	tests := []struct {
+		name string
+		seq  func(Point) []Point
+		p    Point
+		want []Point
+	}{
+		{
+			name: "4 centre",
+			seq:  func(p Point) []Point { return slices.Collect(g.Neighbors4(p)) },
+			p:    Point{1, 1},
+			want: []Point{{0, 1}, {1, 2}, {2, 1}, {1, 0}},
+		},
+		{
+			name: "4 corner",
+			seq:  func(p Point) []Point { return slices.Collect(g.Neighbors4(p)) },
+			p:    Point{0, 0},
+			want: []Point{{0, 1}, {1, 0}},
+		},
+		{
+			name: "8 corner",
+			seq:  func(p Point) []Point { return slices.Collect(g.Neighbors8(p)) },
+			p:    Point{2, 2},
+			want: []Point{{1, 2}, {2, 1}, {1, 1}},
+		},
+		{
+			name: "8 centre",
+			seq:  func(p Point) []Point { return slices.Collect(g.Neighbors8(p)) },
+			p:    Point{1, 1},
+			want: []Point{{0, 1}, {0, 2}, {1, 2}, {2, 2}, {2, 1}, {2, 0}, {1, 0}, {0, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.seq(tt.p); !slices.Equal(got, tt.want) {
+				t.Errorf("neighbours of %v = %v, want %v", tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSetBounds(t *testing.T) {
+	g := New[byte](2, 3)
+	g.Set(Point{2, 1}, 'x')
+
+	if v, ok := g.Get(Point{2, 1}); !ok || v != 'x' {
+		t.Errorf("Get(2,1) = %q, %v", v, ok)
+	}
+	for _, p := range []Point{{-1, 0}, {0, -1}, {3, 0}, {0, 2}} {
+		if g.InBounds(p) {
+			t.Errorf("InBounds(%v) = true", p)
+		}
+		if _, ok := g.Get(p); ok {
+			t.Errorf("Get(%v) ok = true", p)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Set out of bounds did not panic")
+		}
+	}()
+	g.Set(Point{3, 0}, 'y')
+}
+
+func TestClone(t *testing.T) {
+	g, _ := ParseRunes("ab\ncd")
+	c := g.Clone()
+	c.Set(Point{0, 0}, 'z')
+	if g.At(Point{0, 0}) != 'a' {
+		t.Error("Clone shares storage with the original")
+	}
+}
+
+func TestTransformations(t *testing.T) {
+	g, _ := ParseRunes("abc\ndef\n")
+
+	tests := []struct {
+		name string
+		got  *Grid[rune]
+		want string
+	}{
+		{"transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"rotate cw", g.RotateCW(), "da\neb\nfc\n"},
+		{"rotate ccw", g.RotateCCW(), "cf\nbe\nad\n"},
+		{"four turns", g.RotateCW().RotateCW().RotateCW().RotateCW(), "abc\ndef\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	g, _ := ParseDigits("12\n34")
+	if got, want := g.String(), "12\n34\n"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	got := g.Format(func(p Point, v int) rune {
+		if p == (Point{1, 1}) {
+			return '@'
+		}
+		return rune('0' + v)
+	})
+	if want := "12\n3@\n"; got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+}
+
+func TestPointArithmetic(t *testing.T) {
+	p := Point{2, 3}
+	if got := p.Add(Down); got != (Point{3, 3}) {
+		t.Errorf("Add = %v", got)
+	}
+	if got := p.Sub(Point{5, 1}); got != (Point{-3, 2}) {
+		t.Errorf("Sub = %v", got)
+	}
+	if got := Left.Scale(3); got != (Point{0, -3}) {
+		t.Errorf("Scale = %v", got)
+	}
+	if got := p.Manhattan(Point{-1, 5}); got != 5 {
+		t.Errorf("Manhattan = %d", got)
+	}
+}
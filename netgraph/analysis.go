@@ -0,0 +1,185 @@
+package netgraph
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"adventofcode/search"
+)
+
+// Components 返回所有连通分量，每个分量的节点编号升序；
+// 分量按大小降序排列，大小相同时按最小节点编号排列。
+func (g *Graph) Components() [][]int {
+	seen := NewBitset(g.Len())
+	var comps [][]int
+	for start := range g.Len() {
+		if seen.Has(start) {
+			continue
+		}
+		seen.Add(start)
+		comp := []int{start}
+		for head := 0; head < len(comp); head++ {
+			for v := range g.adj[comp[head]].All() {
+				if !seen.Has(v) {
+					seen.Add(v)
+					comp = append(comp, v)
+				}
+			}
+		}
+		slices.Sort(comp)
+		comps = append(comps, comp)
+	}
+	slices.SortStableFunc(comps, func(a, b []int) int { return cmp.Compare(len(b), len(a)) })
+	return comps
+}
+
+// CoreNumbers 返回每个节点的核数：节点属于 k 核（每个节点度数都至少为 k 的
+// 最大子图）的最大 k。用 Batagelj–Zaversnik 算法按度数桶逐个剥离节点，O(n+m)。
+func (g *Graph) CoreNumbers() []int {
+	n := g.Len()
+	degree := make([]int, n)
+	maxDeg := 0
+	for i := range n {
+		degree[i] = g.Degree(i)
+		maxDeg = max(maxDeg, degree[i])
+	}
+
+	// 按度数计数排序：order 中度数不降，pos 是节点在 order 中的位置，
+	// start[d] 是度数为 d 的桶在 order 中的起点。
+	start := make([]int, maxDeg+2)
+	for _, d := range degree {
+		start[d+1]++
+	}
+	for d := range maxDeg + 1 {
+		start[d+1] += start[d]
+	}
+	order, pos := make([]int, n), make([]int, n)
+	next := slices.Clone(start)
+	for v, d := range degree {
+		pos[v] = next[d]
+		order[pos[v]] = v
+		next[d]++
+	}
+
+	for _, v := range order {
+		for u := range g.adj[v].All() {
+			if degree[u] <= degree[v] {
+				continue
+			}
+			// 把 u 移到它所在桶的开头，再把桶的起点后移，u 的度数就减了 1。
+			du := degree[u]
+			w := order[start[du]]
+			order[pos[u]], order[start[du]] = w, u
+			pos[u], pos[w] = start[du], pos[u]
+			start[du]++
+			degree[u]--
+		}
+	}
+	return degree
+}
+
+// DegreeHistogram 返回度数分布：第 d 项是度数为 d 的节点个数。
+func (g *Graph) DegreeHistogram() []int {
+	var hist []int
+	for i := range g.Len() {
+		d := g.Degree(i)
+		for len(hist) <= d {
+			hist = append(hist, 0)
+		}
+		hist[d]++
+	}
+	return hist
+}
+
+// ShortestPath 返回从 from 到 to 跳数最少的一条路径（包含两端）；
+// 两点不连通时返回 nil。
+func (g *Graph) ShortestPath(from, to int) []int {
+	neighbors := func(u int) iter.Seq[int] { return g.adj[u].All() }
+	r := search.BFS(from, neighbors, search.Options[int]{Goal: func(u int) bool { return u == to }})
+	return r.Path(to)
+}
+
+// ArticulationPoints 返回所有割点（删去后连通分量增加的节点），升序。
+func (g *Graph) ArticulationPoints() []int {
+	var points []int
+	g.lowLink(func(u int) { points = append(points, u) }, nil)
+	slices.Sort(points)
+	return points
+}
+
+// Bridges 返回所有桥（删去后连通分量增加的边），每条边 u < v，按字典序排列。
+func (g *Graph) Bridges() [][2]int {
+	var bridges [][2]int
+	g.lowLink(nil, func(u, v int) { bridges = append(bridges, [2]int{min(u, v), max(u, v)}) })
+	slices.SortFunc(bridges, func(a, b [2]int) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+	})
+	return bridges
+}
+
+// lowLink 用 Tarjan 的 DFS 时间戳与 low 值找出割点和桥，每找到一个就回调一次
+// （回调为 nil 时忽略）。DFS 用显式栈实现，长链状的图也不会栈溢出。
+func (g *Graph) lowLink(point func(u int), bridge func(u, v int)) {
+	n := g.Len()
+	disc, low := make([]int, n), make([]int, n)
+	parent := make([]int, n)
+	for i := range disc {
+		disc[i] = -1
+	}
+	type frame struct {
+		u         int
+		neighbors []int
+		next      int
+		children  int
+		isPoint   bool
+	}
+	time := 0
+	for root := range n {
+		if disc[root] >= 0 {
+			continue
+		}
+		parent[root] = -1
+		disc[root], low[root] = time, time
+		time++
+		stack := []*frame{{u: root, neighbors: slices.Collect(g.adj[root].All())}}
+		for len(stack) > 0 {
+			f := stack[len(stack)-1]
+			if f.next < len(f.neighbors) {
+				v := f.neighbors[f.next]
+				f.next++
+				switch {
+				case disc[v] < 0:
+					parent[v] = f.u
+					disc[v], low[v] = time, time
+					time++
+					f.children++
+					stack = append(stack, &frame{u: v, neighbors: slices.Collect(g.adj[v].All())})
+				case v != parent[f.u]:
+					low[f.u] = min(low[f.u], disc[v])
+				}
+				continue
+			}
+
+			// f.u 的子树已经搜索完，把 low 值交给父节点。
+			stack = stack[:len(stack)-1]
+			if f.u == root {
+				if f.children > 1 && point != nil {
+					point(root)
+				}
+				continue
+			}
+			if f.isPoint && point != nil {
+				point(f.u)
+			}
+			p := stack[len(stack)-1]
+			low[p.u] = min(low[p.u], low[f.u])
+			if low[f.u] > disc[p.u] && bridge != nil {
+				bridge(p.u, f.u)
+			}
+			if low[f.u] >= disc[p.u] && p.u != root {
+				p.isPoint = true
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package netgraph
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// componentCount 返回去掉节点 skip 和边 skipEdge 之后的连通分量数（skip 为 -1 表示不去掉节点）。
+func componentCount(g *Graph, skip int, skipEdge [2]int) int {
+	seen := make([]bool, g.Len())
+	count := 0
+	for s := range g.Len() {
+		if s == skip || seen[s] {
+			continue
+		}
+		count++
+		seen[s] = true
+		stack := []int{s}
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for v := range g.Neighbors(u).All() {
+				if v == skip || seen[v] || [2]int{min(u, v), max(u, v)} == skipEdge {
+					continue
+				}
+				seen[v] = true
+				stack = append(stack, v)
+			}
+		}
+	}
+	return count
+}
+
+func TestCutsAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewPCG(19, 23))
+	for trial := range 60 {
+		g := randomGraph(rng, 3+rng.IntN(40), 0.02+0.1*rng.Float64())
+		base := componentCount(g, -1, [2]int{-1, -1})
+
+		var wantPoints []int
+		for u := range g.Len() {
+			// 去掉孤立点会使分量减少，去掉割点使分量增加
+			if componentCount(g, u, [2]int{-1, -1}) > base-boolInt(g.Degree(u) == 0) {
+				wantPoints = append(wantPoints, u)
+			}
+		}
+		if got := g.ArticulationPoints(); !slices.Equal(got, wantPoints) {
+			t.Fatalf("trial %d: ArticulationPoints() = %v, want %v", trial, got, wantPoints)
+		}
+
+		var wantBridges [][2]int
+		for u, v := range g.Edges() {
+			if componentCount(g, -1, [2]int{u, v}) > base {
+				wantBridges = append(wantBridges, [2]int{u, v})
+			}
+		}
+		if got := g.Bridges(); !slices.Equal(got, wantBridges) {
+			t.Fatalf("trial %d: Bridges() = %v, want %v", trial, got, wantBridges)
+		}
+
+		if got := len(g.Components()); got != base {
+			t.Fatalf("trial %d: %d components, want %d", trial, got, base)
+		}
+	}
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestCoreNumbers(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 11))
+	for trial := range 30 {
+		g := randomGraph(rng, 5+rng.IntN(60), 0.05+0.4*rng.Float64())
+		got := g.CoreNumbers()
+
+		// 朴素做法：对每个 k 反复删去度数小于 k 的节点
+		want := make([]int, g.Len())
+		for k := 1; ; k++ {
+			alive := make([]bool, g.Len())
+			for i := range alive {
+				alive[i] = true
+			}
+			for changed := true; changed; {
+				changed = false
+				for u := range g.Len() {
+					if !alive[u] {
+						continue
+					}
+					d := 0
+					for v := range g.Neighbors(u).All() {
+						d += boolInt(alive[v])
+					}
+					if d < k {
+						alive[u], changed = false, true
+					}
+				}
+			}
+			if !slices.Contains(alive, true) {
+				break
+			}
+			for u, a := range alive {
+				if a {
+					want[u] = k
+				}
+			}
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("trial %d: CoreNumbers() = %v, want %v", trial, got, want)
+		}
+	}
+}
+
+func TestPathAndDegrees(t *testing.T) {
+	// a-b-c-d 是一条链，e-f 是另一个分量
+	var edges [][2]string
+	for _, e := range strings.Fields("a-b b-c c-d a-c e-f") {
+		x, y, _ := strings.Cut(e, "-")
+		edges = append(edges, [2]string{x, y})
+	}
+	g := New(edges)
+	id := func(name string) int { i, _ := g.Index(name); return i }
+
+	if got := g.Names(g.ShortestPath(id("a"), id("d"))); !slices.Equal(got, []string{"a", "c", "d"}) {
+		t.Errorf("ShortestPath(a, d) = %v", got)
+	}
+	if got := g.ShortestPath(id("a"), id("f")); got != nil {
+		t.Errorf("ShortestPath(a, f) = %v, want nil", got)
+	}
+	if got := g.Names(g.ShortestPath(id("b"), id("b"))); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("ShortestPath(b, b) = %v", got)
+	}
+	if got := g.DegreeHistogram(); !slices.Equal(got, []int{0, 3, 2, 1}) {
+		t.Errorf("DegreeHistogram() = %v", got)
+	}
+	comps := g.Components()
+	if len(comps) != 2 || !slices.Equal(g.Names(comps[0]), []string{"a", "b", "c", "d"}) {
+		t.Errorf("Components() = %v", comps)
+	}
+	if got := g.Names(g.ArticulationPoints()); !slices.Equal(got, []string{"c"}) {
+		t.Errorf("ArticulationPoints() = %v", got)
+	}
+	if got := g.CoreNumbers(); got[id("a")] != 2 || got[id("d")] != 1 || got[id("e")] != 1 {
+		t.Errorf("CoreNumbers() = %v", got)
+	}
+}
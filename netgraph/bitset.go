@@ -0,0 +1,96 @@
+package netgraph
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// Bitset 是定长的位集合，第 i 位表示编号为 i 的节点。
+type Bitset []uint64
+
+// NewBitset 返回能容纳 n 个元素的空集合。
+func NewBitset(n int) Bitset {
+	return make(Bitset, (n+63)/64)
+}
+
+// Add 把 i 加入集合。
+func (b Bitset) Add(i int) { b[i/64] |= 1 << (i % 64) }
+
+// Remove 把 i 移出集合。
+func (b Bitset) Remove(i int) { b[i/64] &^= 1 << (i % 64) }
+
+// Has 报告 i 是否在集合中。
+func (b Bitset) Has(i int) bool { return b[i/64]&(1<<(i%64)) != 0 }
+
+// Len 返回集合的元素个数。
+func (b Bitset) Len() int {
+	n := 0
+	for _, w := range b {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Empty 报告集合是否为空。
+func (b Bitset) Empty() bool {
+	for _, w := range b {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone 返回集合的副本。
+func (b Bitset) Clone() Bitset {
+	return append(Bitset(nil), b...)
+}
+
+// And 返回 b ∩ other，不修改 b。
+func (b Bitset) And(other Bitset) Bitset {
+	r := make(Bitset, len(b))
+	for i := range b {
+		r[i] = b[i] & other[i]
+	}
+	return r
+}
+
+// AndNot 返回 b \ other，不修改 b。
+func (b Bitset) AndNot(other Bitset) Bitset {
+	r := make(Bitset, len(b))
+	for i := range b {
+		r[i] = b[i] &^ other[i]
+	}
+	return r
+}
+
+// AndLen 返回 |b ∩ other|，不分配内存。
+func (b Bitset) AndLen(other Bitset) int {
+	n := 0
+	for i := range b {
+		n += bits.OnesCount64(b[i] & other[i])
+	}
+	return n
+}
+
+// removeUpTo 移除所有不大于 i 的元素。
+func (b Bitset) removeUpTo(i int) {
+	for w := range i / 64 {
+		b[w] = 0
+	}
+	b[i/64] &^= uint64(1)<<(i%64+1) - 1 // i%64 == 63 时移位结果为 0，掩码为全 1
+}
+
+// All 按从小到大的顺序产出集合中的元素。
+func (b Bitset) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i, w := range b {
+			for w != 0 {
+				if !yield(i*64 + bits.TrailingZeros64(w)) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
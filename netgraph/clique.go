@@ -0,0 +1,87 @@
+package netgraph
+
+import (
+	"iter"
+	"slices"
+)
+
+// MaxClique 返回一个最大团（节点编号升序）。用带枢轴的 Bron–Kerbosch 算法
+// 搜索，并在 "当前团加上全部候选也不可能超过已知最大团" 时剪枝。
+// 有多个最大团时返回其中任意一个；图为空时返回 nil。
+func (g *Graph) MaxClique() []int {
+	var best []int
+	all := NewBitset(g.Len())
+	for i := range g.Len() {
+		all.Add(i)
+	}
+	g.bronKerbosch(nil, all, NewBitset(g.Len()), &best)
+	return best
+}
+
+// bronKerbosch 扩展团 r：p 是还能加入的候选，x 是已经处理过、不能再加入的节点。
+func (g *Graph) bronKerbosch(r []int, p, x Bitset, best *[]int) {
+	if p.Empty() {
+		if x.Empty() && len(r) > len(*best) {
+			*best = append([]int(nil), r...)
+			slices.Sort(*best) // r 按分支顺序排列，不一定有序
+		}
+		return
+	}
+	if len(r)+p.Len() <= len(*best) {
+		return
+	}
+
+	// 选 p ∪ x 中在 p 里邻居最多的节点作枢轴：它的邻居留给以后的分支。
+	pivot, most := -1, -1
+	for _, set := range []Bitset{p, x} {
+		for u := range set.All() {
+			if n := p.AndLen(g.adj[u]); n > most {
+				pivot, most = u, n
+			}
+		}
+	}
+	for v := range p.AndNot(g.adj[pivot]).All() {
+		g.bronKerbosch(append(r, v), p.And(g.adj[v]), x.And(g.adj[v]), best)
+		p.Remove(v)
+		x.Add(v)
+	}
+}
+
+// Cliques 按字典序产出所有恰好有 k 个节点的团（节点编号升序），
+// keep 不为 nil 时只产出 keep 返回 true 的团。产出的切片在下一次迭代时会被
+// 复用，需要保留时请复制。
+func (g *Graph) Cliques(k int, keep func(clique []int) bool) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		if k <= 0 {
+			return
+		}
+		clique := make([]int, 0, k)
+		// extend 在 clique 的基础上从候选 p（都大于 clique 中的节点）中选下一个节点。
+		var extend func(p Bitset) bool
+		extend = func(p Bitset) bool {
+			if len(clique) == k {
+				return keep != nil && !keep(clique) || yield(clique)
+			}
+			for v := range p.All() {
+				// 只保留比 v 大的候选，每个团只按升序生成一次。
+				next := p.And(g.adj[v])
+				next.removeUpTo(v)
+				if next.Len() < k-len(clique)-1 {
+					continue
+				}
+				clique = append(clique, v)
+				ok := extend(next)
+				clique = clique[:len(clique)-1]
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		all := NewBitset(g.Len())
+		for i := range g.Len() {
+			all.Add(i)
+		}
+		extend(all)
+	}
+}
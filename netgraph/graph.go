@@ -0,0 +1,99 @@
+// Package netgraph 处理第 23 天那种无向、无权的网络图：节点是计算机名，
+// 边是 "kh-tc" 形式的连接。
+//
+// 节点按名字排序后编号，邻接关系保存为位集合，判断两点相连、求公共邻居
+// 都是按字操作。在此之上提供带枢轴的 Bron–Kerbosch 最大团、按条件过滤的
+// k 团枚举，以及 O(m√m) 的三角形计数。
+package netgraph
+
+import (
+	"iter"
+	"slices"
+)
+
+// Graph 是一个无向简单图，创建后不再改变。
+type Graph struct {
+	names []string
+	index map[string]int
+	adj   []Bitset
+	edges int
+}
+
+// New 用 edges 中的连接创建图。节点按名字排序编号；自环被忽略，
+// 重复的边只算一次。
+func New(edges [][2]string) *Graph {
+	g := &Graph{index: make(map[string]int)}
+	for _, e := range edges {
+		for _, name := range e {
+			if _, ok := g.index[name]; !ok {
+				g.index[name] = 0
+				g.names = append(g.names, name)
+			}
+		}
+	}
+	slices.Sort(g.names)
+	for i, name := range g.names {
+		g.index[name] = i
+	}
+
+	g.adj = make([]Bitset, len(g.names))
+	for i := range g.adj {
+		g.adj[i] = NewBitset(len(g.names))
+	}
+	for _, e := range edges {
+		u, v := g.index[e[0]], g.index[e[1]]
+		if u == v || g.adj[u].Has(v) {
+			continue
+		}
+		g.adj[u].Add(v)
+		g.adj[v].Add(u)
+		g.edges++
+	}
+	return g
+}
+
+// Len 返回节点数。
+func (g *Graph) Len() int { return len(g.names) }
+
+// EdgeCount 返回边数。
+func (g *Graph) EdgeCount() int { return g.edges }
+
+// Name 返回编号为 i 的节点名。
+func (g *Graph) Name(i int) string { return g.names[i] }
+
+// Names 返回一组节点编号对应的名字。
+func (g *Graph) Names(nodes []int) []string {
+	names := make([]string, len(nodes))
+	for i, n := range nodes {
+		names[i] = g.names[n]
+	}
+	return names
+}
+
+// Index 返回节点名对应的编号。
+func (g *Graph) Index(name string) (int, bool) {
+	i, ok := g.index[name]
+	return i, ok
+}
+
+// Neighbors 返回节点 i 的邻居集合。返回值与图共享，不要修改。
+func (g *Graph) Neighbors(i int) Bitset { return g.adj[i] }
+
+// Degree 返回节点 i 的度数。
+func (g *Graph) Degree(i int) int { return g.adj[i].Len() }
+
+// Connected 报告节点 u、v 之间是否有边。
+func (g *Graph) Connected(u, v int) bool { return g.adj[u].Has(v) }
+
+// Edges 产出每条边一次，u < v。
+func (g *Graph) Edges() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for u, nb := range g.adj {
+			for v := range nb.All() {
+				if u < v && !yield(u, v) {
+					return
+				}
+			}
+		}
+	}
+}
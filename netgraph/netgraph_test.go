@@ -0,0 +1,175 @@
+package netgraph
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// example 是第 23 天谜题中的示例网络。
+const example = `kh-tc qp-kh de-cg ka-co yn-aq qp-ub cg-tb vc-aq tb-ka wh-tc yn-cg kh-ub ta-co de-co tc-td tb-wq
+wh-td ta-ka td-qp aq-cg wq-ub ub-vc de-ta wq-aq wq-vc wh-yn ka-de kh-ta co-tc wh-qp tb-vc td-yn`
+
+func exampleGraph() *Graph {
+	var edges [][2]string
+	for _, f := range strings.Fields(example) {
+		a, b, _ := strings.Cut(f, "-")
+		edges = append(edges, [2]string{a, b})
+	}
+	return New(edges)
+}
+
+// randomGraph 返回 n 个节点、每条边以概率 p 出现的随机图。
+func randomGraph(rng *rand.Rand, n int, p float64) *Graph {
+	var edges [][2]string
+	for u := range n {
+		edges = append(edges, [2]string{fmt.Sprintf("n%03d", u), fmt.Sprintf("n%03d", u)}) // 自环保证每个节点都在图中
+		for v := u + 1; v < n; v++ {
+			if rng.Float64() < p {
+				edges = append(edges, [2]string{fmt.Sprintf("n%03d", u), fmt.Sprintf("n%03d", v)})
+			}
+		}
+	}
+	return New(edges)
+}
+
+// bruteCliques 穷举所有 k 元子集，返回其中的团。
+func bruteCliques(g *Graph, k int) [][]int {
+	var result [][]int
+	var rec func(start int, cur []int)
+	rec = func(start int, cur []int) {
+		if len(cur) == k {
+			result = append(result, slices.Clone(cur))
+			return
+		}
+		for v := start; v < g.Len(); v++ {
+			ok := true
+			for _, u := range cur {
+				ok = ok && g.Connected(u, v)
+			}
+			if ok {
+				rec(v+1, append(cur, v))
+			}
+		}
+	}
+	rec(0, nil)
+	return result
+}
+
+func TestExample(t *testing.T) {
+	g := exampleGraph()
+	if g.Len() != 16 || g.EdgeCount() != 32 {
+		t.Fatalf("graph has %d nodes and %d edges, want 16 and 32", g.Len(), g.EdgeCount())
+	}
+	if got := g.CountTriangles(); got != 12 {
+		t.Errorf("CountTriangles() = %d, want 12", got)
+	}
+	hasT := func(c []int) bool {
+		return slices.ContainsFunc(g.Names(c), func(s string) bool { return strings.HasPrefix(s, "t") })
+	}
+	var withT []string
+	for c := range g.Cliques(3, hasT) {
+		withT = append(withT, strings.Join(g.Names(c), ","))
+	}
+	want := []string{"co,de,ta", "co,ka,ta", "de,ka,ta", "qp,td,wh", "tb,vc,wq", "tc,td,wh", "td,wh,yn"}
+	if !slices.Equal(withT, want) {
+		t.Errorf("t cliques = %v, want %v", withT, want)
+	}
+	if got := strings.Join(g.Names(g.MaxClique()), ","); got != "co,de,ka,ta" {
+		t.Errorf("MaxClique() = %s, want co,de,ka,ta", got)
+	}
+}
+
+func TestRandomGraphs(t *testing.T) {
+	rng := rand.New(rand.NewPCG(23, 2024))
+	for trial := range 40 {
+		n := 5 + rng.IntN(70) // 跨过 64 位的字边界
+		g := randomGraph(rng, n, 0.1+0.4*rng.Float64())
+
+		var maxSize int
+		for k := 1; ; k++ {
+			want := bruteCliques(g, k)
+			var got [][]int
+			for c := range g.Cliques(k, nil) {
+				got = append(got, slices.Clone(c))
+			}
+			if !slices.EqualFunc(got, want, slices.Equal) {
+				t.Fatalf("trial %d: %d-cliques = %v, want %v", trial, k, got, want)
+			}
+			if k == 3 && g.CountTriangles() != len(want) {
+				t.Fatalf("trial %d: CountTriangles() = %d, want %d", trial, g.CountTriangles(), len(want))
+			}
+			if len(want) == 0 {
+				break
+			}
+			maxSize = k
+			if k > 6 && n > 30 {
+				// 大图上穷举太慢，最大团的大小改由下面的检查保证
+				maxSize = -1
+				break
+			}
+		}
+
+		clique := g.MaxClique()
+		if !slices.IsSorted(clique) {
+			t.Fatalf("trial %d: MaxClique() %v is not sorted", trial, clique)
+		}
+		for i, u := range clique {
+			for _, v := range clique[i+1:] {
+				if !g.Connected(u, v) {
+					t.Fatalf("trial %d: MaxClique() %v is not a clique", trial, clique)
+				}
+			}
+		}
+		if maxSize >= 0 && len(clique) != maxSize {
+			t.Fatalf("trial %d: MaxClique() has %d nodes, want %d", trial, len(clique), maxSize)
+		}
+		// 不存在比它大一的团
+		for range g.Cliques(len(clique)+1, nil) {
+			t.Fatalf("trial %d: found a clique larger than MaxClique() %v", trial, clique)
+		}
+	}
+}
+
+func TestCliquesStop(t *testing.T) {
+	g := exampleGraph()
+	n := 0
+	for range g.Cliques(2, nil) {
+		if n++; n == 5 {
+			break
+		}
+	}
+	if n != 5 {
+		t.Errorf("iterated %d cliques, want to stop at 5", n)
+	}
+	for range g.Cliques(0, nil) {
+		t.Error("Cliques(0) produced a clique")
+	}
+	if New(nil).MaxClique() != nil {
+		t.Error("MaxClique() of an empty graph is not nil")
+	}
+}
+
+func TestBitset(t *testing.T) {
+	b := NewBitset(130)
+	for _, i := range []int{0, 63, 64, 129} {
+		b.Add(i)
+	}
+	if got := slices.Collect(b.All()); !slices.Equal(got, []int{0, 63, 64, 129}) {
+		t.Errorf("All() = %v", got)
+	}
+	c := b.Clone()
+	c.removeUpTo(63)
+	if got := slices.Collect(c.All()); !slices.Equal(got, []int{64, 129}) {
+		t.Errorf("after removeUpTo(63): %v", got)
+	}
+	if b.Len() != 4 || b.AndLen(c) != 2 || b.AndNot(c).Len() != 2 || !b.Has(63) {
+		t.Error("set operations disagree")
+	}
+	b.Remove(63)
+	if b.Has(63) || b.Empty() || !NewBitset(10).Empty() {
+		t.Error("Remove/Empty mismatch")
+	}
+}
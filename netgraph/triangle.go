@@ -0,0 +1,68 @@
+package netgraph
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Triangles 产出每个三角形一次（三个节点编号升序）。
+//
+// 按 (度数, 编号) 给节点排序，每条边只从排名低的一端指向排名高的一端。
+// 这样每个节点的出度不超过 √(2m)，对每条有向边 u→v 检查 v 的出邻居是否
+// 也是 u 的出邻居，总耗时 O(m√m)。
+func (g *Graph) Triangles() iter.Seq[[3]int] {
+	return func(yield func([3]int) bool) {
+		n := g.Len()
+		rank := make([]int, n)
+		order := make([]int, n)
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortFunc(order, func(a, b int) int {
+			return cmp.Or(cmp.Compare(g.Degree(a), g.Degree(b)), cmp.Compare(a, b))
+		})
+		for r, u := range order {
+			rank[u] = r
+		}
+		out := make([][]int, n)
+		for u, v := range g.Edges() {
+			if rank[u] < rank[v] {
+				out[u] = append(out[u], v)
+			} else {
+				out[v] = append(out[v], u)
+			}
+		}
+
+		mark := make([]bool, n)
+		for u := range n {
+			for _, v := range out[u] {
+				mark[v] = true
+			}
+			for _, v := range out[u] {
+				for _, w := range out[v] {
+					if !mark[w] {
+						continue
+					}
+					t := [3]int{u, v, w}
+					slices.Sort(t[:])
+					if !yield(t) {
+						return
+					}
+				}
+			}
+			for _, v := range out[u] {
+				mark[v] = false
+			}
+		}
+	}
+}
+
+// CountTriangles 返回图中三角形的个数。
+func (g *Graph) CountTriangles() int {
+	n := 0
+	for range g.Triangles() {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,207 @@
+// Package parse 提供各天解析谜题输入时共用的工具：从 io.Reader 读取输入
+// （透明解压 gzip，"-" 表示标准输入），按行遍历，以及带行号、列号的解析错误。
+//
+// 行号和列号都从 1 开始，列号按字符（rune）计数。
+package parse
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Error 是带位置信息的解析错误。Col 为 0 表示错误针对整行。
+type Error struct {
+	Line, Col int
+	Err       error
+}
+
+func (e *Error) Error() string {
+	if e.Col > 0 {
+		return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Col, e.Err)
+	}
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Errorf 返回位于 line 行 col 列的解析错误。
+func Errorf(line, col int, format string, args ...any) error {
+	return &Error{Line: line, Col: col, Err: fmt.Errorf(format, args...)}
+}
+
+// At 给 err 加上位置信息。err 已经是 *Error 时，其行号视为相对于 line 行
+// 开始的偏移（第 1 行即 line 行）；列号不变，这样嵌套的解析函数只需报告自己
+// 那一段里的位置。err 为 nil 时返回 nil。
+func At(line, col int, err error) error {
+	if err == nil {
+		return nil
+	}
+	var pe *Error
+	if errors.As(err, &pe) {
+		return &Error{Line: line + pe.Line - 1, Col: pe.Col, Err: pe.Err}
+	}
+	return &Error{Line: line, Col: col, Err: err}
+}
+
+// gzipMagic 是 gzip 数据的前两个字节。
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// Text 读取 r 的全部内容并以字符串返回；内容是 gzip 压缩的时自动解压。
+func Text(r io.Reader) (string, error) {
+	data, err := ReadAll(r)
+	return string(data), err
+}
+
+// ReadAll 读取 r 的全部内容；内容是 gzip 压缩的时自动解压。
+func ReadAll(r io.Reader) ([]byte, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(len(gzipMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if !bytes.Equal(head, gzipMagic) {
+		return io.ReadAll(br)
+	}
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+	defer zr.Close()
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+	return data, nil
+}
+
+// ReadFile 读取 path 处的输入并自动解压 gzip；path 为 "-" 时读取标准输入。
+func ReadFile(path string) ([]byte, error) {
+	if path == "-" {
+		return ReadAll(os.Stdin)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadAll(f)
+}
+
+// Lines 依次产出 text 的每一行及其行号，行尾的 "\r" 会被去掉。
+// 末尾换行符之后的空串不算作一行。
+func Lines(text string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		text = strings.TrimSuffix(text, "\n")
+		if text == "" {
+			return
+		}
+		lineNo := 0
+		for line := range strings.SplitSeq(text, "\n") {
+			lineNo++
+			if !yield(lineNo, strings.TrimSuffix(line, "\r")) {
+				return
+			}
+		}
+	}
+}
+
+// Blocks 依次产出 text 中以空行分隔的段落，以及每段第一行的行号。
+// 连续多个空行视为一个分隔；每段都不带结尾的换行符。
+func Blocks(text string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		var block []string
+		start := 0
+		flush := func() bool {
+			if len(block) == 0 {
+				return true
+			}
+			ok := yield(start, strings.Join(block, "\n"))
+			block = block[:0]
+			return ok
+		}
+		for lineNo, line := range Lines(text) {
+			if strings.TrimSpace(line) == "" {
+				if !flush() {
+					return
+				}
+				continue
+			}
+			if len(block) == 0 {
+				start = lineNo
+			}
+			block = append(block, line)
+		}
+		flush()
+	}
+}
+
+// Field 是一行中的一个字段及其起始列号。
+type Field struct {
+	Text string
+	Col  int
+}
+
+// Fields 按 sep 判定的分隔字符切分 line，并记录每个字段的起始列号。
+// sep 为 nil 时按空白字符切分。
+func Fields(line string, sep func(rune) bool) []Field {
+	if sep == nil {
+		sep = unicode.IsSpace
+	}
+	var fields []Field
+	start, startCol, col := -1, 0, 0
+	for i, r := range line {
+		col++
+		switch {
+		case sep(r) && start >= 0:
+			fields = append(fields, Field{line[start:i], startCol})
+			start = -1
+		case !sep(r) && start < 0:
+			start, startCol = i, col
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, Field{line[start:], startCol})
+	}
+	return fields
+}
+
+// Int 把字段解析为十进制整数，失败时返回指向该字段的错误。
+func (f Field) Int(lineNo int) (int, error) {
+	n, err := strconv.Atoi(f.Text)
+	if err != nil {
+		return 0, Errorf(lineNo, f.Col, "invalid number %q", f.Text)
+	}
+	return n, nil
+}
+
+// Ints 把 line 中用 sep 分隔的字段都解析为整数（sep 为 nil 时按空白切分）。
+func Ints(lineNo int, line string, sep func(rune) bool) ([]int, error) {
+	fields := Fields(line, sep)
+	nums := make([]int, len(fields))
+	for i, f := range fields {
+		n, err := f.Int(lineNo)
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = n
+	}
+	return nums, nil
+}
+
+// Col 返回 line 中字节偏移 offset 处的列号。
+func Col(line string, offset int) int {
+	return utf8.RuneCountInString(line[:offset]) + 1
+}
+
+// Comma 是按逗号切分字段的分隔判定函数，逗号两侧的空白也视为分隔。
+func Comma(r rune) bool { return r == ',' || unicode.IsSpace(r) }
@@ -0,0 +1,149 @@
+package parse
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestErrorFormat(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{Errorf(3, 7, "bad %s", "thing"), "line 3, column 7: bad thing"},
+		{Errorf(3, 0, "bad line"), "line 3: bad line"},
+		{At(10, 2, errors.New("boom")), "line 10, column 2: boom"},
+		{At(10, 2, Errorf(3, 5, "nested")), "line 12, column 5: nested"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+	if At(1, 1, nil) != nil {
+		t.Error("At(1, 1, nil) != nil")
+	}
+
+	sentinel := errors.New("sentinel")
+	if err := At(4, 1, sentinel); !errors.Is(err, sentinel) {
+		t.Errorf("errors.Is(%v, sentinel) = false", err)
+	}
+}
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := io.WriteString(zw, s); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestText(t *testing.T) {
+	const input = "1 2\n3 4\n"
+	tests := []struct {
+		name string
+		r    io.Reader
+		want string
+	}{
+		{"plain", strings.NewReader(input), input},
+		{"gzip", bytes.NewReader(gzipped(t, input)), input},
+		{"empty", strings.NewReader(""), ""},
+		{"one byte", strings.NewReader("\x1f"), "\x1f"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Text(tt.r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Text() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Text(bytes.NewReader([]byte{0x1f, 0x8b, 0})); err == nil {
+		t.Error("Text(truncated gzip) succeeded, want error")
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.gz")
+	if err := os.WriteFile(path, gzipped(t, "hello\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello\n" {
+		t.Errorf("ReadFile() = %q, want %q", got, "hello\n")
+	}
+}
+
+func TestLines(t *testing.T) {
+	var got []string
+	for n, line := range Lines("a\r\nb\n\nc\n") {
+		got = append(got, fmt.Sprintf("%d:%s", n, line))
+	}
+	if want := []string{"1:a", "2:b", "3:", "4:c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %q, want %q", got, want)
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	type block struct {
+		start int
+		text  string
+	}
+	var got []block
+	for start, text := range Blocks("\na\nb\n\n\nc\n") {
+		got = append(got, block{start, text})
+	}
+	if want := []block{{2, "a\nb"}, {6, "c"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Blocks() = %v, want %v", got, want)
+	}
+}
+
+func TestFields(t *testing.T) {
+	got := Fields("  ab  c d", nil)
+	want := []Field{{"ab", 3}, {"c", 7}, {"d", 9}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %v, want %v", got, want)
+	}
+
+	got = Fields("é,1, 22", Comma)
+	want = []Field{{"é", 1}, {"1", 3}, {"22", 6}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields(Comma) = %v, want %v", got, want)
+	}
+}
+
+func TestInts(t *testing.T) {
+	nums, err := Ints(1, "3 -4  5", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{3, -4, 5}; !reflect.DeepEqual(nums, want) {
+		t.Errorf("Ints() = %v, want %v", nums, want)
+	}
+
+	_, err = Ints(7, "1,2,x3", Comma)
+	var pe *Error
+	if !errors.As(err, &pe) || pe.Line != 7 || pe.Col != 5 {
+		t.Errorf("Ints() error = %v, want line 7, column 5", err)
+	}
+}
@@ -0,0 +1,182 @@
+package puzzle
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Attempt 是一次答案提交的记录。
+type Attempt struct {
+	Time    time.Time
+	Day     int
+	Part    int
+	Answer  string
+	Verdict Verdict
+	Wait    time.Duration // 站点要求的冷却时间
+}
+
+// AttemptLog 是追加写入的提交记录文件，每行一次提交：
+//
+//	2024-12-18T05:12:03Z 18 2 too-low 22,49 1m0s
+type AttemptLog struct {
+	path     string
+	attempts []Attempt
+}
+
+// DefaultAttemptLog 返回默认的提交记录文件路径，与输入缓存放在一起。
+func DefaultAttemptLog(year int) (string, error) {
+	cache, err := DefaultCache(year)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cache.Dir, "attempts.log"), nil
+}
+
+// OpenAttempts 读取 path 处的提交记录；文件不存在时视为没有记录。
+func OpenAttempts(path string) (*AttemptLog, error) {
+	l := &AttemptLog{path: path}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for lineNo := 1; sc.Scan(); lineNo++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		a, err := parseAttempt(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNo, err)
+		}
+		l.attempts = append(l.attempts, a)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func parseAttempt(line string) (Attempt, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 6 {
+		return Attempt{}, fmt.Errorf("want 6 fields, got %d", len(fields))
+	}
+	var a Attempt
+	var err error
+	if a.Time, err = time.Parse(time.RFC3339, fields[0]); err != nil {
+		return Attempt{}, err
+	}
+	if a.Day, err = strconv.Atoi(fields[1]); err != nil {
+		return Attempt{}, err
+	}
+	if a.Part, err = strconv.Atoi(fields[2]); err != nil {
+		return Attempt{}, err
+	}
+	var ok bool
+	if a.Verdict, ok = parseVerdictName(fields[3]); !ok {
+		return Attempt{}, fmt.Errorf("unknown verdict %q", fields[3])
+	}
+	a.Answer = fields[4]
+	if a.Wait, err = time.ParseDuration(fields[5]); err != nil {
+		return Attempt{}, err
+	}
+	return a, nil
+}
+
+// Attempts 返回 day/part 的所有提交记录（按提交顺序）。
+func (l *AttemptLog) Attempts(day, part int) []Attempt {
+	var out []Attempt
+	for _, a := range l.attempts {
+		if a.Day == day && a.Part == part {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// Record 把一次提交追加到记录文件。
+func (l *AttemptLog) Record(a Attempt) error {
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f, "%s %d %d %s %s %s\n",
+		a.Time.UTC().Format(time.RFC3339), a.Day, a.Part, a.Verdict, a.Answer, a.Wait)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	l.attempts = append(l.attempts, a)
+	return nil
+}
+
+var (
+	// ErrAlreadySolved 表示记录中这一部分已经答对，不需要再提交。
+	ErrAlreadySolved = errors.New("already solved")
+	// ErrKnownWrong 表示这个答案（或根据偏大/偏小的提示可以推断出）是错的。
+	ErrKnownWrong = errors.New("answer is known to be wrong")
+)
+
+// CooldownError 表示站点要求的冷却时间还没有结束。
+type CooldownError struct {
+	Until time.Time
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("cooling down until %s", e.Until.Local().Format(time.TimeOnly))
+}
+
+// Check 根据已有记录判断现在能否提交 answer。不能提交时返回
+// ErrAlreadySolved、包装了 ErrKnownWrong 的错误或 *CooldownError。
+// 只有记录中有答对的提交才算已经解出：AlreadySolved 的响应也可能表示这一部分
+// 尚未解锁，解锁后还要能再提交。
+func (l *AttemptLog) Check(day, part int, answer string, now time.Time) error {
+	n, numeric := parseAnswerNumber(answer)
+	var until time.Time
+	for _, a := range l.Attempts(day, part) {
+		if end := a.Time.Add(a.Wait); end.After(until) {
+			until = end
+		}
+		switch {
+		case a.Verdict == Correct:
+			return fmt.Errorf("day %d part %d: %w (answer was %s)", day, part, ErrAlreadySolved, a.Answer)
+		case a.Verdict.IsWrong() && a.Answer == answer:
+			return fmt.Errorf("%s was already submitted at %s: %w (%s)", answer, a.Time.Local().Format(time.DateTime), ErrKnownWrong, a.Verdict)
+		}
+		if prev, ok := parseAnswerNumber(a.Answer); ok && numeric {
+			if a.Verdict == TooHigh && n >= prev {
+				return fmt.Errorf("%s is not below %s, which was too high: %w", answer, a.Answer, ErrKnownWrong)
+			}
+			if a.Verdict == TooLow && n <= prev {
+				return fmt.Errorf("%s is not above %s, which was too low: %w", answer, a.Answer, ErrKnownWrong)
+			}
+		}
+	}
+	if now.Before(until) {
+		return &CooldownError{Until: until}
+	}
+	return nil
+}
+
+func parseAnswerNumber(s string) (int64, bool) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	return n, err == nil
+}
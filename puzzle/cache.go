@@ -0,0 +1,88 @@
+package puzzle
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"adventofcode/parse"
+)
+
+// Cache 是下载过的谜题输入在本地的存放位置，每一天一个文件。
+type Cache struct {
+	Dir string
+}
+
+// DefaultCache 返回默认的缓存位置：环境变量 AOC_CACHE_DIR，
+// 否则为用户缓存目录下的 aoc/<year>。
+func DefaultCache(year int) (*Cache, error) {
+	if dir := os.Getenv("AOC_CACHE_DIR"); dir != "" {
+		return &Cache{Dir: filepath.Join(dir, fmt.Sprint(year))}, nil
+	}
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("puzzle: %w", err)
+	}
+	return &Cache{Dir: filepath.Join(base, "aoc", fmt.Sprint(year))}, nil
+}
+
+// Path 返回某一天的缓存文件路径。
+func (c *Cache) Path(day int) string {
+	return filepath.Join(c.Dir, fmt.Sprintf("day%02d.txt", day))
+}
+
+// Load 读取某一天的缓存输入；没有缓存时返回 false。
+func (c *Cache) Load(day int) ([]byte, bool, error) {
+	data, err := os.ReadFile(c.Path(day))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// Store 保存某一天的输入。先写临时文件再改名，中断时不会留下半截的缓存。
+func (c *Cache) Store(day int, data []byte) error {
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(c.Dir, ".input-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.Path(day))
+}
+
+// ReadInput 读取 path 处的输入文件（"-" 为标准输入，gzip 压缩的会被自动解压）；
+// 文件不存在时退而读取默认缓存中该天的输入。各天的 main 用它代替 os.ReadFile("input")。
+func ReadInput(path string, day int) ([]byte, error) {
+	data, err := parse.ReadFile(path)
+	if !errors.Is(err, fs.ErrNotExist) {
+		return data, err
+	}
+	cache, cacheErr := DefaultCache(DefaultYear)
+	if cacheErr != nil {
+		return nil, err
+	}
+	cached, ok, cacheErr := cache.Load(day)
+	if cacheErr != nil {
+		return nil, cacheErr
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w (and no cached input for day %d; run \"aoc fetch %d\")", err, day, day)
+	}
+	return cached, nil
+}
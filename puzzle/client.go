@@ -0,0 +1,158 @@
+// Package puzzle 负责和 adventofcode.com 打交道：用会话令牌下载谜题输入，
+// 并把输入缓存在用户缓存目录中，每一天只保存一份（两个部分共用同一份输入）；
+// 提交答案、解析响应页面，并在本地记录每次提交以避免重复提交错误答案。
+//
+// 会话令牌依次从环境变量 AOC_SESSION 和用户配置目录下的 aoc/session 文件读取。
+// 站点要求自动化工具尽量少发请求并在 User-Agent 中注明来源，所以客户端会
+// 限制请求间隔，而已缓存的输入不会再次下载。
+package puzzle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL 是谜题站点的地址。
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultYear 是本仓库所解的日历年份。
+	DefaultYear = 2024
+	// DefaultUserAgent 标明请求来自本仓库的工具。
+	DefaultUserAgent = "adventofcode-go/cmd/aoc (personal puzzle tooling; net/http)"
+	// DefaultMinInterval 是同一客户端两次请求之间的最短间隔。
+	DefaultMinInterval = 3 * time.Second
+)
+
+var (
+	// ErrSessionRejected 表示站点不接受会话令牌（未登录或令牌过期）。
+	ErrSessionRejected = errors.New("session token rejected; log in again and update the token")
+	// ErrNotUnlocked 表示该天的谜题尚未解锁。
+	ErrNotUnlocked = errors.New("puzzle is not unlocked yet")
+)
+
+// HTTPError 是站点返回的其他非 200 响应。
+type HTTPError struct {
+	StatusCode int
+	Body       string // 响应正文的开头部分，便于排查
+}
+
+func (e *HTTPError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected HTTP status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected HTTP status %d: %s", e.StatusCode, e.Body)
+}
+
+// Client 是谜题站点的客户端。零值不可用，请使用 New。
+type Client struct {
+	BaseURL     string
+	Year        int
+	Session     string
+	UserAgent   string
+	MinInterval time.Duration
+	HTTP        *http.Client
+
+	mu   sync.Mutex
+	last time.Time // 上一次请求发出的时间
+}
+
+// New 返回使用给定会话令牌和默认设置的客户端。
+func New(session string) *Client {
+	return &Client{
+		BaseURL:     DefaultBaseURL,
+		Year:        DefaultYear,
+		Session:     session,
+		UserAgent:   DefaultUserAgent,
+		MinInterval: DefaultMinInterval,
+		HTTP:        &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Input 下载某一天的谜题输入。
+func (c *Client) Input(ctx context.Context, day int) ([]byte, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("puzzle: invalid day %d", day)
+	}
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", c.Year, day), nil)
+	if err != nil {
+		return nil, fmt.Errorf("puzzle: day %d input: %w", day, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("puzzle: day %d input: %w", day, err)
+	}
+	if err := checkStatus(resp.StatusCode, body); err != nil {
+		return nil, fmt.Errorf("puzzle: day %d input: %w", day, err)
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("puzzle: day %d input: empty response", day)
+	}
+	return body, nil
+}
+
+// do 在遵守请求间隔的前提下发出一个带会话 cookie 的请求。
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	if c.Session == "" {
+		return nil, ErrSessionRejected
+	}
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.BaseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	return c.HTTP.Do(req)
+}
+
+// wait 阻塞到距离上一次请求至少 MinInterval 之后，并登记本次请求的时间。
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.last.IsZero() {
+		if d := c.MinInterval - time.Since(c.last); d > 0 {
+			t := time.NewTimer(d)
+			defer t.Stop()
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	c.last = time.Now()
+	return nil
+}
+
+// checkStatus 把站点的错误响应转换为对应的错误。
+func checkStatus(code int, body []byte) error {
+	switch {
+	case code == http.StatusOK:
+		return nil
+	case code == http.StatusNotFound:
+		return ErrNotUnlocked
+	case code == http.StatusBadRequest, code == http.StatusUnauthorized,
+		code == http.StatusInternalServerError && strings.Contains(string(body), "log in"):
+		return ErrSessionRejected
+	}
+	snippet := strings.TrimSpace(string(body))
+	if len(snippet) > 200 {
+		snippet = snippet[:200] + "…"
+	}
+	return &HTTPError{StatusCode: code, Body: snippet}
+}
@@ -0,0 +1,188 @@
+package puzzle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeSite 模拟谜题站点的输入下载接口：只有 2024 年第 1–5 天已解锁，
+// 会话令牌必须为 "good"。
+func fakeSite(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if ua := r.Header.Get("User-Agent"); !strings.Contains(ua, "adventofcode-go") {
+			t.Errorf("User-Agent = %q", ua)
+		}
+		if c, err := r.Cookie("session"); err != nil || c.Value != "good" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/2024/day/1/input", "/2024/day/5/input":
+			w.Write([]byte("input for " + r.URL.Path + "\n"))
+		case "/2024/day/7/input":
+			http.Error(w, "overloaded", http.StatusServiceUnavailable)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func testClient(url, session string) *Client {
+	c := New(session)
+	c.BaseURL = url
+	c.MinInterval = 0
+	return c
+}
+
+func TestInput(t *testing.T) {
+	var requests atomic.Int32
+	srv := fakeSite(t, &requests)
+
+	tests := []struct {
+		name    string
+		session string
+		day     int
+		want    string
+		wantErr error
+	}{
+		{name: "ok", session: "good", day: 5, want: "input for /2024/day/5/input\n"},
+		{name: "bad session", session: "bad", day: 1, wantErr: ErrSessionRejected},
+		{name: "no session", session: "", day: 1, wantErr: ErrSessionRejected},
+		{name: "locked", session: "good", day: 25, wantErr: ErrNotUnlocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testClient(srv.URL, tt.session).Input(context.Background(), tt.day)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Input = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	_, err := testClient(srv.URL, "good").Input(context.Background(), 7)
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable || httpErr.Body != "overloaded" {
+		t.Errorf("err = %v, want HTTPError 503 with body", err)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	var requests atomic.Int32
+	srv := fakeSite(t, &requests)
+	c := testClient(srv.URL, "good")
+	c.MinInterval = 50 * time.Millisecond
+
+	start := time.Now()
+	for range 3 {
+		if _, err := c.Input(context.Background(), 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 2*c.MinInterval {
+		t.Errorf("3 requests took %s, want at least %s", elapsed, 2*c.MinInterval)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.MinInterval = time.Hour
+	if _, err := c.Input(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled while throttled", err)
+	}
+	if n := requests.Load(); n != 3 {
+		t.Errorf("server saw %d requests, want 3", n)
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := &Cache{Dir: filepath.Join(t.TempDir(), "2024")}
+	if _, ok, err := c.Load(3); ok || err != nil {
+		t.Fatalf("Load on empty cache = %v, %v", ok, err)
+	}
+	if err := c.Store(3, []byte("abc")); err != nil {
+		t.Fatal(err)
+	}
+	data, ok, err := c.Load(3)
+	if err != nil || !ok || string(data) != "abc" {
+		t.Errorf("Load = %q, %v, %v", data, ok, err)
+	}
+	if want := filepath.Join(c.Dir, "day03.txt"); c.Path(3) != want {
+		t.Errorf("Path = %s, want %s", c.Path(3), want)
+	}
+}
+
+func TestReadInputFallsBackToCache(t *testing.T) {
+	t.Setenv("AOC_CACHE_DIR", t.TempDir())
+	cache, err := DefaultCache(DefaultYear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(t.TempDir(), "input")
+
+	if _, err := ReadInput(missing, 4); err == nil || !strings.Contains(err.Error(), "aoc fetch 4") {
+		t.Errorf("err = %v, want a hint to fetch the input", err)
+	}
+
+	if err := cache.Store(4, []byte("cached")); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := ReadInput(missing, 4); err != nil || string(data) != "cached" {
+		t.Errorf("ReadInput = %q, %v; want cached input", data, err)
+	}
+
+	local := filepath.Join(t.TempDir(), "input")
+	if err := os.WriteFile(local, []byte("local"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := ReadInput(local, 4); err != nil || string(data) != "local" {
+		t.Errorf("ReadInput = %q, %v; want the local file to win", data, err)
+	}
+}
+
+func TestLoadSession(t *testing.T) {
+	t.Setenv("AOC_SESSION", " abc123\n")
+	if s, err := LoadSession(); err != nil || s != "abc123" {
+		t.Errorf("LoadSession = %q, %v", s, err)
+	}
+
+	t.Setenv("AOC_SESSION", "")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	if _, err := LoadSession(); err == nil {
+		t.Error("expected an error without a token")
+	}
+	path, err := SessionFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("fromfile\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := LoadSession(); err != nil || s != "fromfile" {
+		t.Errorf("LoadSession = %q, %v", s, err)
+	}
+}
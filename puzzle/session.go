@@ -0,0 +1,43 @@
+package puzzle
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LoadSession 返回会话令牌：优先使用环境变量 AOC_SESSION，
+// 否则读取用户配置目录下的 aoc/session 文件。
+func LoadSession() (string, error) {
+	if s := strings.TrimSpace(os.Getenv("AOC_SESSION")); s != "" {
+		return s, nil
+	}
+	path, err := SessionFile()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("puzzle: no session token: set AOC_SESSION or write it to %s", path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("puzzle: %w", err)
+	}
+	s := strings.TrimSpace(string(data))
+	if s == "" {
+		return "", fmt.Errorf("puzzle: session file %s is empty", path)
+	}
+	return s, nil
+}
+
+// SessionFile 返回会话令牌配置文件的路径。
+func SessionFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("puzzle: %w", err)
+	}
+	return filepath.Join(dir, "aoc", "session"), nil
+}
@@ -0,0 +1,143 @@
+package puzzle
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict 是站点对一次答案提交的判定。
+type Verdict int
+
+const (
+	Unknown       Verdict = iota // 无法识别的响应页面
+	Correct                      // 答案正确
+	Wrong                        // 答案错误，站点没有提示偏大还是偏小
+	TooHigh                      // 答案错误且偏大
+	TooLow                       // 答案错误且偏小
+	TooSoon                      // 提交过于频繁，需要等待
+	AlreadySolved                // 这一部分已经解出（或尚未解锁）
+)
+
+var verdictNames = [...]string{
+	Unknown:       "unknown",
+	Correct:       "right",
+	Wrong:         "wrong",
+	TooHigh:       "too-high",
+	TooLow:        "too-low",
+	TooSoon:       "wait",
+	AlreadySolved: "already-solved",
+}
+
+func (v Verdict) String() string {
+	if v < 0 || int(v) >= len(verdictNames) {
+		return fmt.Sprintf("Verdict(%d)", int(v))
+	}
+	return verdictNames[v]
+}
+
+// IsWrong 报告该判定是否说明答案本身是错的。
+func (v Verdict) IsWrong() bool {
+	return v == Wrong || v == TooHigh || v == TooLow
+}
+
+// parseVerdictName 是 Verdict.String 的逆操作。
+func parseVerdictName(s string) (Verdict, bool) {
+	for v, name := range verdictNames {
+		if name == s {
+			return Verdict(v), true
+		}
+	}
+	return Unknown, false
+}
+
+// Response 是解析后的提交结果。
+type Response struct {
+	Verdict Verdict
+	// Wait 是站点要求在下次提交前等待的时间；没有要求时为 0。
+	Wait time.Duration
+	// Message 是响应页面正文的纯文本，便于展示给用户。
+	Message string
+}
+
+// Submit 提交某一天某一部分的答案并解析响应页面。
+func (c *Client) Submit(ctx context.Context, day, part int, answer string) (Response, error) {
+	if day < 1 || day > 25 || part < 1 || part > 2 {
+		return Response{}, fmt.Errorf("puzzle: invalid day %d part %d", day, part)
+	}
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", c.Year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Response{}, fmt.Errorf("puzzle: submit day %d part %d: %w", day, part, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Response{}, fmt.Errorf("puzzle: submit day %d part %d: %w", day, part, err)
+	}
+	if err := checkStatus(resp.StatusCode, body); err != nil {
+		return Response{}, fmt.Errorf("puzzle: submit day %d part %d: %w", day, part, err)
+	}
+	return ParseResponse(string(body)), nil
+}
+
+var (
+	articleRe = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRe     = regexp.MustCompile(`<[^>]+>`)
+	spaceRe   = regexp.MustCompile(`\s+`)
+	// "You have 4m 32s left to wait." / "You have 32s left to wait."
+	leftRe = regexp.MustCompile(`you have (?:(\d+)m )?(\d+)s left to wait`)
+	// "please wait one minute before trying again" / "please wait 5 minutes before trying again"
+	pleaseWaitRe = regexp.MustCompile(`please wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseResponse 解析提交答案后站点返回的页面。
+func ParseResponse(page string) Response {
+	text := page
+	if m := articleRe.FindStringSubmatch(page); m != nil {
+		text = m[1]
+	}
+	text = html.UnescapeString(tagRe.ReplaceAllString(text, " "))
+	text = strings.TrimSpace(spaceRe.ReplaceAllString(text, " "))
+
+	r := Response{Message: text}
+	lower := strings.ToLower(text)
+	switch {
+	case strings.Contains(lower, "that's the right answer"):
+		r.Verdict = Correct
+	case strings.Contains(lower, "you gave an answer too recently"):
+		r.Verdict = TooSoon
+	case strings.Contains(lower, "that's not the right answer"):
+		switch {
+		case strings.Contains(lower, "your answer is too high"):
+			r.Verdict = TooHigh
+		case strings.Contains(lower, "your answer is too low"):
+			r.Verdict = TooLow
+		default:
+			r.Verdict = Wrong
+		}
+	case strings.Contains(lower, "you don't seem to be solving the right level"):
+		r.Verdict = AlreadySolved
+	}
+
+	if m := leftRe.FindStringSubmatch(lower); m != nil {
+		minutes, _ := strconv.Atoi(m[1]) // m[1] 可能为空，此时按 0 分钟计
+		seconds, _ := strconv.Atoi(m[2])
+		r.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := pleaseWaitRe.FindStringSubmatch(lower); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		r.Wait = time.Duration(minutes) * time.Minute
+	}
+	return r
+}
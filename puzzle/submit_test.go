@@ -0,0 +1,160 @@
+package puzzle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// 以下页面按站点的实际响应精简而来。
+const (
+	pageRight = `<html><body><main><article><p>That's the right answer!  You are <span class="day-success">one gold star</span> closer to finding the Chief Historian. <a href="/2024/day/18#part2">[Continue to Part Two]</a></p></article></main></body></html>`
+
+	pageTooHigh = `<main><article><p>That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data; there are also some general tips on the <a href="/2024/about">about page</a>, or you can ask for hints on the <a href="https://www.reddit.com/r/adventofcode/" target="_blank">subreddit</a>.  Please wait one minute before trying again. <a href="/2024/day/18">[Return to Day 18]</a></p></article></main>`
+
+	pageTooLow = `<main><article><p>That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again. <a href="/2024/day/18">[Return to Day 18]</a></p></article></main>`
+
+	pageWrong = `<main><article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data.  Please wait one minute before trying again.</p></article></main>`
+
+	pageTooSoon = `<main><article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 32s left to wait. <a href="/2024/day/18">[Return to Day 18]</a></p></article></main>`
+
+	pageTooSoonSeconds = `<main><article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 45s left to wait.</p></article></main>`
+
+	pageSolved = `<main><article><p>You don't seem to be solving the right level.  Did you already complete it? <a href="/2024/day/18">[Return to Day 18]</a></p></article></main>`
+)
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     string
+		want     Verdict
+		wantWait time.Duration
+	}{
+		{"right", pageRight, Correct, 0},
+		{"too high", pageTooHigh, TooHigh, time.Minute},
+		{"too low", pageTooLow, TooLow, 5 * time.Minute},
+		{"wrong", pageWrong, Wrong, time.Minute},
+		{"too soon", pageTooSoon, TooSoon, 4*time.Minute + 32*time.Second},
+		{"too soon seconds", pageTooSoonSeconds, TooSoon, 45 * time.Second},
+		{"already solved", pageSolved, AlreadySolved, 0},
+		{"unrecognised", "<html>maintenance</html>", Unknown, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseResponse(tt.page)
+			if got.Verdict != tt.want || got.Wait != tt.wantWait {
+				t.Errorf("ParseResponse = %v, wait %s; want %v, wait %s (message %q)", got.Verdict, got.Wait, tt.want, tt.wantWait, got.Message)
+			}
+		})
+	}
+
+	if got := ParseResponse(pageRight).Message; got != "That's the right answer! You are one gold star closer to finding the Chief Historian. [Continue to Part Two]" {
+		t.Errorf("Message = %q", got)
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2024/day/18/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("level") != "2" {
+			t.Errorf("level = %q", r.PostForm.Get("level"))
+		}
+		if r.PostForm.Get("answer") == "22,50" {
+			w.Write([]byte(pageRight))
+		} else {
+			w.Write([]byte(pageWrong))
+		}
+	}))
+	defer srv.Close()
+
+	c := testClient(srv.URL, "good")
+	got, err := c.Submit(context.Background(), 18, 2, "22,50")
+	if err != nil || got.Verdict != Correct {
+		t.Errorf("Submit = %v, %v; want right", got.Verdict, err)
+	}
+	got, err = c.Submit(context.Background(), 18, 2, "1,1")
+	if err != nil || got.Verdict != Wrong {
+		t.Errorf("Submit = %v, %v; want wrong", got.Verdict, err)
+	}
+	if _, err := c.Submit(context.Background(), 18, 3, "x"); err == nil {
+		t.Error("part 3 was accepted")
+	}
+}
+
+func TestAttemptLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "attempts.log")
+	l, err := OpenAttempts(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t0 := time.Date(2024, 12, 5, 6, 0, 0, 0, time.UTC)
+	for _, a := range []Attempt{
+		{Time: t0, Day: 5, Part: 1, Answer: "500", Verdict: TooHigh, Wait: time.Minute},
+		{Time: t0.Add(2 * time.Minute), Day: 5, Part: 1, Answer: "100", Verdict: TooLow, Wait: 5 * time.Minute},
+		{Time: t0, Day: 6, Part: 1, Answer: "41", Verdict: Correct},
+		{Time: t0, Day: 7, Part: 2, Answer: "70", Verdict: AlreadySolved},
+	} {
+		if err := l.Record(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 重新打开，确认记录能从文件读回。
+	l, err = OpenAttempts(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(l.Attempts(5, 1)); n != 2 {
+		t.Fatalf("Attempts(5, 1) = %d records, want 2", n)
+	}
+
+	later := t0.Add(time.Hour)
+	tests := []struct {
+		name         string
+		day, part    int
+		answer       string
+		now          time.Time
+		wantErr      error
+		wantCooldown bool
+	}{
+		{name: "in range", day: 5, part: 1, answer: "300", now: later},
+		{name: "same wrong answer", day: 5, part: 1, answer: "500", now: later, wantErr: ErrKnownWrong},
+		{name: "above too high", day: 5, part: 1, answer: "600", now: later, wantErr: ErrKnownWrong},
+		{name: "below too low", day: 5, part: 1, answer: "99", now: later, wantErr: ErrKnownWrong},
+		{name: "cooling down", day: 5, part: 1, answer: "300", now: t0.Add(3 * time.Minute), wantCooldown: true},
+		{name: "solved", day: 6, part: 1, answer: "42", now: later, wantErr: ErrAlreadySolved},
+		{name: "other part", day: 6, part: 2, answer: "41", now: later},
+		// 未解锁时站点也回答 "not solving the right level"，不能因此永远拒绝提交。
+		{name: "locked part", day: 7, part: 2, answer: "70", now: later},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := l.Check(tt.day, tt.part, tt.answer, tt.now)
+			var cooldown *CooldownError
+			switch {
+			case tt.wantCooldown:
+				if !errors.As(err, &cooldown) || !cooldown.Until.Equal(t0.Add(7*time.Minute)) {
+					t.Errorf("err = %v, want cooldown until %s", err, t0.Add(7*time.Minute))
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("err = %v, want %v", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Errorf("err = %v, want nil", err)
+			}
+		})
+	}
+}
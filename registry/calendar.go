@@ -0,0 +1,81 @@
+package registry
+
+import (
+	"adventofcode/day01"
+	"adventofcode/day02"
+	"adventofcode/day03"
+	"adventofcode/day04"
+	"adventofcode/day05"
+	"adventofcode/day06"
+	"adventofcode/day07"
+	"adventofcode/day08"
+	"adventofcode/day09"
+	"adventofcode/day10"
+	"adventofcode/day11"
+	"adventofcode/day12"
+	"adventofcode/day13"
+	"adventofcode/day14"
+	"adventofcode/day15"
+	"adventofcode/day16"
+	"adventofcode/day17"
+	"adventofcode/day18"
+	"adventofcode/day19"
+	"adventofcode/day20"
+	"adventofcode/day21"
+	"adventofcode/day22"
+	"adventofcode/day23"
+	"adventofcode/day24"
+	"adventofcode/day25"
+)
+
+// Calendar 返回登记了仓库中所有已实现求解函数的注册表。
+// 第 2–5 天只保留了第二部分的实现，第 25 天只有一部分。
+func Calendar() *Registry {
+	r := New()
+	r.Register(1, 1, Adapt(day01.Part1))
+	r.Register(1, 2, Adapt(day01.Part2))
+	r.Register(2, 2, Adapt(day02.Part2))
+	r.Register(3, 2, Adapt(day03.Part2))
+	r.Register(4, 2, Adapt(day04.Part2))
+	r.Register(5, 2, Adapt(day05.Part2))
+	r.Register(6, 1, Adapt(day06.Part1))
+	r.Register(6, 2, Adapt(day06.Part2))
+	r.Register(7, 1, Adapt(day07.Part1))
+	r.Register(7, 2, Adapt(day07.Part2))
+	r.Register(8, 1, Adapt(day08.Part1))
+	r.Register(8, 2, Adapt(day08.Part2))
+	r.Register(9, 1, Adapt(day09.Part1))
+	r.Register(9, 2, Adapt(day09.Part2))
+	r.Register(10, 1, Adapt(day10.Part1))
+	r.Register(10, 2, Adapt(day10.Part2))
+	r.Register(11, 1, Adapt(day11.Part1))
+	r.Register(11, 2, Adapt(day11.Part2))
+	r.Register(12, 1, Adapt(day12.Part1))
+	r.Register(12, 2, Adapt(day12.Part2))
+	r.Register(13, 1, Adapt(day13.Part1))
+	r.Register(13, 2, Adapt(day13.Part2))
+	r.Register(14, 1, Adapt(day14.Part1))
+	r.Register(14, 2, Adapt(day14.Part2))
+	r.Register(15, 1, Adapt(day15.Part1))
+	r.Register(15, 2, Adapt(day15.Part2))
+	r.Register(16, 1, Adapt(day16.Part1))
+	r.Register(16, 2, Adapt(day16.Part2))
+	r.Register(17, 1, Adapt(day17.Part1))
+	r.Register(17, 2, Adapt(day17.Part2))
+	r.Register(18, 1, Adapt(day18.Part1))
+	r.Register(18, 2, Adapt(day18.Part2))
+	r.Register(19, 1, Adapt(day19.Part1))
+	r.Register(19, 2, Adapt(day19.Part2))
+	r.Register(20, 1, Adapt(day20.Part1))
+	r.Register(20, 2, Adapt(day20.Part2))
+	r.Register(21, 1, Adapt(day21.Part1))
+	r.Register(21, 2, Adapt(day21.Part2))
+	r.Register(22, 1, Adapt(day22.Part1))
+	r.Register(22, 2, Adapt(day22.Part2))
+	r.Register(23, 1, Adapt(day23.Part1))
+	r.Register(23, 2, Adapt(day23.Part2))
+	r.Register(24, 1, Adapt(day24.Part1))
+	r.Register(24, 2, Adapt(day24.Part2))
+	r.Register(25, 1, Adapt(day25.Part1))
+	return r
+}
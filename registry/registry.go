@@ -0,0 +1,88 @@
+// Package registry 把每一天的 Part1/Part2 求解函数登记到统一的表中，
+// 供 cmd/aoc 按 (day, part) 调度。
+package registry
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Solver 接收完整的谜题输入，返回格式化后的答案。
+type Solver func(input string) (string, error)
+
+// Adapt 把返回任意答案类型的 PartN 函数包装成 Solver，答案用 fmt.Sprint 格式化。
+func Adapt[T any](solve func(input string) (T, error)) Solver {
+	return func(input string) (string, error) {
+		answer, err := solve(input)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(answer), nil
+	}
+}
+
+// Entry 是注册表中的一项。
+type Entry struct {
+	Day   int
+	Part  int
+	Solve Solver
+}
+
+type key struct {
+	day, part int
+}
+
+// Registry 保存所有已登记的求解函数。
+type Registry struct {
+	solvers map[key]Solver
+}
+
+// New 返回一个空的注册表。
+func New() *Registry {
+	return &Registry{solvers: make(map[key]Solver)}
+}
+
+// Register 登记某一天某一部分的求解函数。重复登记或参数越界会 panic，
+// 因为这只可能是代码里的笔误。
+func (r *Registry) Register(day, part int, solve Solver) {
+	if day < 1 || day > 25 || part < 1 || part > 2 {
+		panic(fmt.Sprintf("registry: invalid day %d part %d", day, part))
+	}
+	k := key{day, part}
+	if _, dup := r.solvers[k]; dup {
+		panic(fmt.Sprintf("registry: day %d part %d registered twice", day, part))
+	}
+	r.solvers[k] = solve
+}
+
+// Lookup 返回某一天某一部分的求解函数。
+func (r *Registry) Lookup(day, part int) (Solver, bool) {
+	solve, ok := r.solvers[key{day, part}]
+	return solve, ok
+}
+
+// Entries 按 day、part 升序返回所有登记项。
+func (r *Registry) Entries() []Entry {
+	entries := make([]Entry, 0, len(r.solvers))
+	for k, solve := range r.solvers {
+		entries = append(entries, Entry{Day: k.day, Part: k.part, Solve: solve})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Day != entries[j].Day {
+			return entries[i].Day < entries[j].Day
+		}
+		return entries[i].Part < entries[j].Part
+	})
+	return entries
+}
+
+// Parts 返回某一天已登记的部分编号（升序）。
+func (r *Registry) Parts(day int) []int {
+	var parts []int
+	for part := 1; part <= 2; part++ {
+		if _, ok := r.solvers[key{day, part}]; ok {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
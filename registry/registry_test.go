@@ -0,0 +1,91 @@
+package registry
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAdapt(t *testing.T) {
+	tests := []struct {
+		name    string
+		solve   Solver
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "int answer",
+			solve: Adapt(func(string) (int, error) { return 42, nil }),
+			want:  "42",
+		},
+		{
+			name:  "string answer",
+			solve: Adapt(func(string) (string, error) { return "22,50", nil }),
+			want:  "22,50",
+		},
+		{
+			name:    "error is passed through",
+			solve:   Adapt(func(string) (uint64, error) { return 0, errors.New("boom") }),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.solve("")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	r := New()
+	solve := Adapt(func(string) (int, error) { return 0, nil })
+	r.Register(1, 1, solve)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic on duplicate registration")
+		}
+	}()
+	r.Register(1, 1, solve)
+}
+
+func TestEntriesSorted(t *testing.T) {
+	r := New()
+	solve := Adapt(func(string) (int, error) { return 0, nil })
+	r.Register(10, 2, solve)
+	r.Register(2, 2, solve)
+	r.Register(10, 1, solve)
+
+	var got [][2]int
+	for _, e := range r.Entries() {
+		got = append(got, [2]int{e.Day, e.Part})
+	}
+	want := [][2]int{{2, 2}, {10, 1}, {10, 2}}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	r := Calendar()
+	if n := len(r.Entries()); n != 45 {
+		t.Errorf("Calendar() has %d entries, want 45", n)
+	}
+	if _, ok := r.Lookup(16, 2); !ok {
+		t.Error("day 16 part 2 is not registered")
+	}
+	if got := r.Parts(25); len(got) != 1 || got[0] != 1 {
+		t.Errorf("Parts(25) = %v, want [1]", got)
+	}
+}
@@ -0,0 +1,120 @@
+package render
+
+import (
+	"errors"
+	"image"
+	"image/gif"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Animation 收集求解过程中的帧并写成 GIF 动画。
+//
+// 求解代码在每一步调用 Step；帧的数量超过 MaxFrames 时，Animation 丢弃一半
+// 已有的帧并把采样间隔加倍，所以不论模拟多少步，动画都保持在 MaxFrames 帧
+// 以内，且各帧在时间上大致均匀分布。只有被采样的步骤才会调用 draw，
+// 未被采样的步骤几乎没有开销。
+//
+// nil 的 *Animation 是合法的，它的 Step 和 Frame 什么也不做，
+// 这样求解函数在不需要渲染时可以直接传 nil。
+type Animation struct {
+	// Scale 是写出时每个单元格的像素边长。
+	Scale int
+	// Delay 是相邻两帧之间的间隔，单位为 1/100 秒。
+	Delay int
+	// MaxFrames 是 Step 采样得到的帧数上限，不含 Frame 追加的帧。
+	MaxFrames int
+
+	frames []*image.Paletted // Step 采样得到的帧
+	final  []*image.Paletted // Frame 追加的帧
+	steps  int               // Step 被调用的次数
+	stride int               // 每 stride 步采样一帧
+}
+
+// NewAnimation 返回使用默认参数的 Animation：每格 4 像素、每帧 0.04 秒、最多 400 帧。
+func NewAnimation() *Animation {
+	return &Animation{Scale: 4, Delay: 4, MaxFrames: 400}
+}
+
+// Step 记录模拟前进了一步；这一步被采样时调用 draw 生成帧。
+func (a *Animation) Step(draw func() *image.Paletted) {
+	if a == nil {
+		return
+	}
+	if a.stride == 0 {
+		a.stride = 1
+	}
+	step := a.steps
+	a.steps++
+	if step%a.stride != 0 {
+		return
+	}
+	if a.MaxFrames > 0 && len(a.frames) >= a.MaxFrames {
+		// 只保留偶数位置的帧，它们恰好是步长加倍后会采样的那些步。
+		kept := a.frames[:0]
+		for i, f := range a.frames {
+			if i%2 == 0 {
+				kept = append(kept, f)
+			}
+		}
+		clear(a.frames[len(kept):])
+		a.frames = kept
+		a.stride *= 2
+		if step%a.stride != 0 {
+			return
+		}
+	}
+	a.frames = append(a.frames, draw())
+}
+
+// Frame 无条件地追加一帧，通常用于最终状态，使动画总以结果结束。
+// 追加的帧不参与采样，始终排在所有采样帧之后。
+func (a *Animation) Frame(img *image.Paletted) {
+	if a == nil {
+		return
+	}
+	a.final = append(a.final, img)
+}
+
+// Frames 返回动画中的所有帧（每个单元格一个像素）。
+func (a *Animation) Frames() []*image.Paletted {
+	all := make([]*image.Paletted, 0, len(a.frames)+len(a.final))
+	return append(append(all, a.frames...), a.final...)
+}
+
+// Steps 返回 Step 被调用的总次数。
+func (a *Animation) Steps() int { return a.steps }
+
+// errNoFrames 表示动画中没有任何帧可写。
+var errNoFrames = errors.New("render: animation has no frames")
+
+// WriteGIF 把动画写成 GIF。最后一帧停留一秒，方便看清结果。
+func (a *Animation) WriteGIF(w io.Writer) error {
+	frames := a.Frames()
+	if len(frames) == 0 {
+		return errNoFrames
+	}
+	g := &gif.GIF{
+		Image: make([]*image.Paletted, len(frames)),
+		Delay: make([]int, len(frames)),
+	}
+	for i, f := range frames {
+		g.Image[i] = Scale(f, a.Scale)
+		g.Delay[i] = a.Delay
+	}
+	g.Delay[len(frames)-1] = max(a.Delay, 100)
+	return gif.EncodeAll(w, g)
+}
+
+// Save 把动画写入 path：扩展名为 .png 时只写最后一帧，否则写成 GIF。
+func (a *Animation) Save(path string) error {
+	if strings.EqualFold(filepath.Ext(path), ".png") {
+		frames := a.Frames()
+		if len(frames) == 0 {
+			return errNoFrames
+		}
+		return SavePNG(path, frames[len(frames)-1], a.Scale)
+	}
+	return writeFile(path, a.WriteGIF)
+}
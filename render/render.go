@@ -0,0 +1,123 @@
+// Package render 把网格谜题的状态画成图片，用于观察求解过程：单帧输出为
+// PNG，连续的帧输出为 GIF 动画。只依赖标准库的 image 系列包。
+//
+// 每一帧先按单元格的值给整张网格着色，再依次画上若干图层（路径、机器人、
+// 访问过的格子……）。帧以每个单元格一个像素的大小生成，写出时再按 Scale
+// 放大，这样求解代码不需要关心图片尺寸。
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"iter"
+	"os"
+
+	"adventofcode/grid"
+)
+
+// 调色板中的颜色。所有帧共用同一个调色板，GIF 不需要为每帧单独量化。
+var (
+	Black     = color.RGBA{0x10, 0x10, 0x18, 0xff}
+	White     = color.RGBA{0xf0, 0xf0, 0xf0, 0xff}
+	Gray      = color.RGBA{0x80, 0x80, 0x80, 0xff}
+	DarkGray  = color.RGBA{0x38, 0x38, 0x40, 0xff}
+	Red       = color.RGBA{0xe0, 0x30, 0x30, 0xff}
+	Green     = color.RGBA{0x30, 0xc0, 0x50, 0xff}
+	DarkGreen = color.RGBA{0x18, 0x60, 0x28, 0xff}
+	Blue      = color.RGBA{0x30, 0x70, 0xe0, 0xff}
+	Yellow    = color.RGBA{0xf0, 0xd0, 0x30, 0xff}
+	Orange    = color.RGBA{0xf0, 0x90, 0x20, 0xff}
+	Brown     = color.RGBA{0x90, 0x60, 0x30, 0xff}
+	Cyan      = color.RGBA{0x30, 0xd0, 0xd0, 0xff}
+	Magenta   = color.RGBA{0xd0, 0x40, 0xd0, 0xff}
+)
+
+// Palette 是所有帧使用的调色板。不在调色板中的颜色会被映射到最接近的一种。
+var Palette = color.Palette{
+	Black, White, Gray, DarkGray, Red, Green, DarkGreen, Blue, Yellow, Orange, Brown, Cyan, Magenta,
+}
+
+// Overlay 是叠加在网格上的一个图层：把 Points 中的每个坐标涂成 Color。
+// 网格外的坐标会被忽略。
+type Overlay struct {
+	Color  color.Color
+	Points iter.Seq[grid.Point]
+}
+
+// Image 把网格画成每个单元格一个像素的图片：先用 style 给每个单元格着色，
+// 再按顺序画上各个图层（后面的图层覆盖前面的）。
+func Image[T any](g *grid.Grid[T], style func(p grid.Point, v T) color.Color, overlays ...Overlay) *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, g.Width, g.Height), Palette)
+	for p, v := range g.All() {
+		img.SetColorIndex(p.Col, p.Row, index(style(p, v)))
+	}
+	for _, o := range overlays {
+		if o.Points == nil {
+			continue
+		}
+		i := index(o.Color)
+		for p := range o.Points {
+			if g.InBounds(p) {
+				img.SetColorIndex(p.Col, p.Row, i)
+			}
+		}
+	}
+	return img
+}
+
+// index 返回 c 在 Palette 中的下标，常用颜色直接命中，其余取最接近的颜色。
+func index(c color.Color) uint8 {
+	if rgba, ok := c.(color.RGBA); ok {
+		for i, pc := range Palette {
+			if pc == rgba {
+				return uint8(i)
+			}
+		}
+	}
+	return uint8(Palette.Index(c))
+}
+
+// Scale 返回把 img 的每个像素放大为 k×k 方块后的图片；k <= 1 时原样返回。
+func Scale(img *image.Paletted, k int) *image.Paletted {
+	if k <= 1 {
+		return img
+	}
+	b := img.Bounds()
+	out := image.NewPaletted(image.Rect(0, 0, b.Dx()*k, b.Dy()*k), img.Palette)
+	for y := 0; y < b.Dy(); y++ {
+		row := out.Pix[y*k*out.Stride : y*k*out.Stride+b.Dx()*k]
+		for x := 0; x < b.Dx(); x++ {
+			i := img.ColorIndexAt(b.Min.X+x, b.Min.Y+y)
+			for dx := range k {
+				row[x*k+dx] = i
+			}
+		}
+		for dy := 1; dy < k; dy++ {
+			copy(out.Pix[(y*k+dy)*out.Stride:], row)
+		}
+	}
+	return out
+}
+
+// WritePNG 把 img 放大 scale 倍后以 PNG 格式写入 w。
+func WritePNG(w io.Writer, img *image.Paletted, scale int) error {
+	return png.Encode(w, Scale(img, scale))
+}
+
+// SavePNG 把 img 放大 scale 倍后写成 path 处的 PNG 文件。
+func SavePNG(path string, img *image.Paletted, scale int) error {
+	return writeFile(path, func(w io.Writer) error { return WritePNG(w, img, scale) })
+}
+
+// writeFile 创建 path 并用 write 写入内容，关闭文件时的错误也会被返回。
+func writeFile(path string, write func(io.Writer) error) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, f.Close()) }()
+	return write(f)
+}
@@ -0,0 +1,176 @@
+package render
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"adventofcode/grid"
+)
+
+func wallStyle(_ grid.Point, r rune) color.Color {
+	if r == '#' {
+		return Gray
+	}
+	return Black
+}
+
+func TestImage(t *testing.T) {
+	g, err := grid.ParseRunes("#..\n.#.\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := Image(g, wallStyle,
+		Overlay{Color: Blue, Points: slices.Values([]grid.Point{{Row: 0, Col: 1}, {Row: 1, Col: 2}})},
+		Overlay{Color: Red, Points: slices.Values([]grid.Point{{Row: 1, Col: 2}, {Row: 5, Col: 5}})}, // 网格外的点被忽略
+		Overlay{Color: Green},
+	)
+
+	if b := img.Bounds(); b.Dx() != 3 || b.Dy() != 2 {
+		t.Fatalf("bounds = %v, want 3x2", b)
+	}
+	want := [][]color.Color{
+		{Gray, Blue, Black},
+		{Black, Gray, Red},
+	}
+	for y, row := range want {
+		for x, c := range row {
+			if got := img.At(x, y); got != c {
+				t.Errorf("At(%d, %d) = %v, want %v", x, y, got, c)
+			}
+		}
+	}
+
+	// 不在调色板中的颜色映射到最接近的颜色
+	if got := Palette[index(color.RGBA{0xff, 0, 0, 0xff})]; got != Red {
+		t.Errorf("nearest to pure red = %v, want %v", got, Red)
+	}
+}
+
+func TestScale(t *testing.T) {
+	img := image.NewPaletted(image.Rect(0, 0, 2, 1), Palette)
+	img.SetColorIndex(1, 0, 1)
+	big := Scale(img, 3)
+	if b := big.Bounds(); b.Dx() != 6 || b.Dy() != 3 {
+		t.Fatalf("bounds = %v, want 6x3", b)
+	}
+	for y := range 3 {
+		for x := range 6 {
+			want := uint8(0)
+			if x >= 3 {
+				want = 1
+			}
+			if got := big.ColorIndexAt(x, y); got != want {
+				t.Errorf("ColorIndexAt(%d, %d) = %d, want %d", x, y, got, want)
+			}
+		}
+	}
+	if Scale(img, 1) != img {
+		t.Error("Scale(img, 1) should return img unchanged")
+	}
+}
+
+// stepFrame 返回一帧 1x1 的图片，用 Pix 记录它是第几步画的。
+func stepFrame(step int) *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, 1, 1), Palette)
+	img.Pix[0] = uint8(step)
+	return img
+}
+
+func TestAnimationSampling(t *testing.T) {
+	a := &Animation{Scale: 1, Delay: 1, MaxFrames: 4}
+	draws := 0
+	for step := range 11 {
+		a.Step(func() *image.Paletted {
+			draws++
+			return stepFrame(step)
+		})
+	}
+	a.Frame(stepFrame(12))
+
+	var got []int
+	for _, f := range a.Frames() {
+		got = append(got, int(f.Pix[0]))
+	}
+	// 11 步超过了 4 帧的上限两次，采样间隔变为 4：第 0、4、8 步，再加上最终帧。
+	if want := []int{0, 4, 8, 12}; !slices.Equal(got, want) {
+		t.Errorf("frames = %v, want %v", got, want)
+	}
+	if a.Steps() != 11 {
+		t.Errorf("Steps() = %d, want 11", a.Steps())
+	}
+	if draws >= 11 {
+		t.Errorf("draw called %d times, want fewer than one per step", draws)
+	}
+}
+
+func TestNilAnimation(t *testing.T) {
+	var a *Animation
+	a.Step(func() *image.Paletted {
+		t.Fatal("draw called on nil animation")
+		return nil
+	})
+	a.Frame(stepFrame(0))
+}
+
+func TestWriteGIF(t *testing.T) {
+	a := NewAnimation()
+	a.Scale = 2
+	for step := range 3 {
+		a.Step(func() *image.Paletted { return stepFrame(step) })
+	}
+
+	var buf bytes.Buffer
+	if err := a.WriteGIF(&buf); err != nil {
+		t.Fatal(err)
+	}
+	g, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Image) != 3 {
+		t.Fatalf("decoded %d frames, want 3", len(g.Image))
+	}
+	if b := g.Image[0].Bounds(); b.Dx() != 2 || b.Dy() != 2 {
+		t.Errorf("frame bounds = %v, want 2x2", b)
+	}
+	if g.Delay[2] < 100 {
+		t.Errorf("last frame delay = %d, want at least 100", g.Delay[2])
+	}
+
+	if err := NewAnimation().WriteGIF(&buf); err == nil {
+		t.Error("WriteGIF on an empty animation succeeded, want error")
+	}
+}
+
+func TestSavePNG(t *testing.T) {
+	a := NewAnimation()
+	a.Step(func() *image.Paletted { return stepFrame(0) })
+	a.Frame(stepFrame(4))
+
+	path := filepath.Join(t.TempDir(), "last.png")
+	if err := a.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != a.Scale || b.Dy() != a.Scale {
+		t.Errorf("bounds = %v, want %dx%d", b, a.Scale, a.Scale)
+	}
+	if got := color.RGBAModel.Convert(img.At(0, 0)); got != Palette[4] {
+		t.Errorf("pixel = %v, want the last frame's color %v", img.At(0, 0), Palette[4])
+	}
+}
@@ -0,0 +1,117 @@
+package result
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Format 是结果的输出格式。
+type Format string
+
+const (
+	// Text 每个结果输出一行 "day  N part P: answer (duration)"，
+	// 诊断信息以缩进的 "key: value" 行跟在后面。
+	Text Format = "text"
+	// JSON 每个结果输出一个 JSON 对象，独占一行（JSON Lines）。
+	JSON Format = "json"
+)
+
+// ParseFormat 解析 "--format" 参数的值。
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case Text, JSON:
+		return f, nil
+	}
+	return "", fmt.Errorf("invalid format %q: want text or json", s)
+}
+
+// String 和 Set 让 *Format 可以直接作为 flag.Value 使用。
+func (f *Format) String() string { return string(*f) }
+
+func (f *Format) Set(s string) error {
+	parsed, err := ParseFormat(s)
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
+
+// jsonResult 是 Result 的 JSON 表示。
+type jsonResult struct {
+	Day         int               `json:"day"`
+	Part        int               `json:"part"`
+	Answer      string            `json:"answer,omitempty"`
+	DurationNS  int64             `json:"duration_ns"`
+	Diagnostics map[string]string `json:"diagnostics,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
+
+// MarshalJSON 把结果编码为 JSON，耗时以纳秒整数表示，错误以字符串表示。
+func (r Result) MarshalJSON() ([]byte, error) {
+	j := jsonResult{
+		Day:         r.Day,
+		Part:        r.Part,
+		Answer:      r.Answer,
+		DurationNS:  r.Duration.Nanoseconds(),
+		Diagnostics: r.Diagnostics,
+	}
+	if r.Err != nil {
+		j.Answer = ""
+		j.Error = r.Err.Error()
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON 解码 MarshalJSON 的输出。
+func (r *Result) UnmarshalJSON(data []byte) error {
+	var j jsonResult
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*r = Result{
+		Day:         j.Day,
+		Part:        j.Part,
+		Answer:      j.Answer,
+		Duration:    time.Duration(j.DurationNS),
+		Diagnostics: j.Diagnostics,
+	}
+	if j.Error != "" {
+		r.Err = jsonError(j.Error)
+	}
+	return nil
+}
+
+// jsonError 是从 JSON 中解码出的错误，只保留错误信息。
+type jsonError string
+
+func (e jsonError) Error() string { return string(e) }
+
+// Write 按格式 f 把 r 写入 w。文本格式下失败的结果写入 errw，
+// 使标准输出中只有答案；JSON 格式下所有结果都写入 w。
+func Write(w, errw io.Writer, f Format, r Result) error {
+	if f == JSON {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	}
+
+	if r.Err != nil {
+		_, err := fmt.Fprintf(errw, "day %d part %d: %v\n", r.Day, r.Part, r.Err)
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "day %2d part %d: %s (%s)\n", r.Day, r.Part, r.Answer, r.Duration.Round(time.Microsecond)); err != nil {
+		return err
+	}
+	for _, k := range r.diagnosticKeys() {
+		if _, err := fmt.Fprintf(w, "  %s: %s\n", k, r.Diagnostics[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
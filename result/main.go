@@ -0,0 +1,65 @@
+package result
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"adventofcode/puzzle"
+)
+
+// Main 是 dayNN/partN 下各个 main 的共用入口，命令行为
+//
+//	[--format text|json] [--input path | path]
+//
+// 默认读取当前目录下的 input 文件，不存在时使用 "aoc fetch" 的缓存；
+// "-" 表示标准输入，gzip 压缩的输入会被自动解压。Main 运行 solve 并按所选格式
+// 输出结果，求解失败时以退出码 1 结束进程。
+//
+// finish 在求解成功之后、输出之前依次调用，可以向结果中补充诊断信息；
+// 返回的错误记为求解失败。需要额外参数的 main（例如 --render）可以在调用
+// Main 之前向 flag.CommandLine 注册，Main 会负责解析。
+func Main[T any](day, part int, solve func(input string) (T, error), finish ...func(*Result) error) {
+	os.Exit(run(flag.CommandLine, os.Args[1:], os.Stdout, os.Stderr, day, part, solve, finish))
+}
+
+// run 实现 Main，返回进程退出码。
+func run[T any](fs *flag.FlagSet, args []string, stdout, stderr io.Writer, day, part int, solve func(string) (T, error), finish []func(*Result) error) int {
+	format := Text
+	fs.Var(&format, "format", "output format: text or json")
+	inputPath := fs.String("input", "input", "puzzle input file (\"-\" for stdin, may be gzip-compressed)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	switch fs.NArg() {
+	case 0:
+	case 1:
+		*inputPath = fs.Arg(0)
+	default:
+		fmt.Fprintf(stderr, "unexpected arguments %q\n", fs.Args()[1:])
+		return 2
+	}
+
+	r := Result{Day: day, Part: part}
+	if data, err := puzzle.ReadInput(*inputPath, day); err != nil {
+		r.Err = err
+	} else {
+		r = Solve(day, part, solve, string(data))
+	}
+	for _, f := range finish {
+		if r.Err != nil {
+			break
+		}
+		r.Err = f(&r)
+	}
+
+	if err := Write(stdout, stderr, format, r); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if r.Err != nil {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+// Package result 定义求解结果的统一结构，以及把结果输出为文本或 JSON 的方法。
+//
+// 所有入口（cmd/aoc 的各个子命令和 dayNN/partN 下的 main）都通过这里输出答案，
+// 这样脚本和看板只需解析 "--format json" 的输出，而不必去匹配各天自由格式的
+// printf 文本。
+package result
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// Result 是某一天某一部分的一次求解结果。
+type Result struct {
+	Day      int
+	Part     int
+	Answer   string
+	Duration time.Duration
+	// Diagnostics 是附加的诊断信息，例如渲染输出的文件或与记录答案的比对结果。
+	Diagnostics map[string]string
+	// Err 是求解失败的原因；不为 nil 时 Answer 没有意义。
+	Err error
+}
+
+// Solve 调用 solve 求解 input 并计时，答案用 fmt.Sprint 格式化。
+// solve 中的 panic 会被转换为错误，这样批量运行不会因为某一天的实现崩溃而中断。
+func Solve[T any](day, part int, solve func(input string) (T, error), input string) Result {
+	r := Result{Day: day, Part: part}
+	start := time.Now()
+	answer, err := solveSafely(solve, input)
+	r.Duration = time.Since(start)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.Answer = fmt.Sprint(answer)
+	return r
+}
+
+func solveSafely[T any](solve func(string) (T, error), input string) (answer T, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("solver panicked: %v", v)
+		}
+	}()
+	return solve(input)
+}
+
+// Note 记录一条诊断信息，同名的信息会被覆盖。
+func (r *Result) Note(key, format string, args ...any) {
+	if r.Diagnostics == nil {
+		r.Diagnostics = make(map[string]string)
+	}
+	r.Diagnostics[key] = fmt.Sprintf(format, args...)
+}
+
+// diagnosticKeys 按字母顺序返回诊断信息的键，使文本输出的顺序固定。
+func (r *Result) diagnosticKeys() []string {
+	return slices.Sorted(maps.Keys(r.Diagnostics))
+}
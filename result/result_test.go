@@ -0,0 +1,171 @@
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSolve(t *testing.T) {
+	r := Solve(3, 1, func(input string) (int, error) { return len(input), nil }, "abcd")
+	if r.Day != 3 || r.Part != 1 || r.Answer != "4" || r.Err != nil {
+		t.Errorf("Solve() = %+v, want day 3 part 1 answer 4", r)
+	}
+
+	boom := errors.New("boom")
+	r = Solve(3, 2, func(string) (string, error) { return "ignored", boom }, "")
+	if !errors.Is(r.Err, boom) || r.Answer != "" {
+		t.Errorf("Solve() = %+v, want error %v and no answer", r, boom)
+	}
+
+	r = Solve(3, 2, func(string) (int, error) { panic("oops") }, "")
+	if r.Err == nil || !strings.Contains(r.Err.Error(), "oops") {
+		t.Errorf("Solve() error = %v, want the panic converted to an error", r.Err)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	ok := Result{Day: 5, Part: 2, Answer: "5479", Duration: 1234567 * time.Nanosecond}
+	ok.Note("render", "%d frames", 12)
+	ok.Note("a", "first")
+	failed := Result{Day: 17, Part: 1, Duration: time.Microsecond, Err: errors.New("bad input")}
+
+	tests := []struct {
+		name       string
+		format     Format
+		r          Result
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "text",
+			format:     Text,
+			r:          ok,
+			wantStdout: "day  5 part 2: 5479 (1.235ms)\n  a: first\n  render: 12 frames\n",
+		},
+		{
+			name:       "text error",
+			format:     Text,
+			r:          failed,
+			wantStderr: "day 17 part 1: bad input\n",
+		},
+		{
+			name:       "json",
+			format:     JSON,
+			r:          ok,
+			wantStdout: `{"day":5,"part":2,"answer":"5479","duration_ns":1234567,"diagnostics":{"a":"first","render":"12 frames"}}` + "\n",
+		},
+		{
+			name:       "json error",
+			format:     JSON,
+			r:          failed,
+			wantStdout: `{"day":17,"part":1,"duration_ns":1000,"error":"bad input"}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if err := Write(&stdout, &stderr, tt.format, tt.r); err != nil {
+				t.Fatal(err)
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+			if stderr.String() != tt.wantStderr {
+				t.Errorf("stderr = %q, want %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	want := Result{Day: 1, Part: 2, Answer: "31", Duration: 42, Diagnostics: map[string]string{"k": "v"}}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Result
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Day != want.Day || got.Part != want.Part || got.Answer != want.Answer ||
+		got.Duration != want.Duration || got.Diagnostics["k"] != "v" || got.Err != nil {
+		t.Errorf("round trip = %+v, want %+v", got, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"day":1,"part":1,"error":"boom"}`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Err == nil || got.Err.Error() != "boom" {
+		t.Errorf("Err = %v, want boom", got.Err)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, s := range []string{"text", "json"} {
+		if f, err := ParseFormat(s); err != nil || string(f) != s {
+			t.Errorf("ParseFormat(%q) = %q, %v", s, f, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(xml) succeeded, want error")
+	}
+}
+
+func TestRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input")
+	if err := os.WriteFile(path, []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	length := func(input string) (int, error) { return len(input), nil }
+
+	tests := []struct {
+		name       string
+		args       []string
+		finish     []func(*Result) error
+		wantCode   int
+		wantStdout string
+	}{
+		{"positional path", []string{"--format", "json", path}, nil, 0, `"answer":"5"`},
+		{"input flag", []string{"--input", path}, nil, 0, "day  1 part 2: 5 ("},
+		{
+			name: "finish adds diagnostics",
+			args: []string{"--input", path},
+			finish: []func(*Result) error{func(r *Result) error {
+				r.Note("extra", "yes")
+				return nil
+			}},
+			wantStdout: "  extra: yes\n",
+		},
+		{
+			name:       "finish error",
+			args:       []string{"--input", path, "--format", "json"},
+			finish:     []func(*Result) error{func(*Result) error { return errors.New("cannot save") }},
+			wantCode:   1,
+			wantStdout: `"error":"cannot save"`,
+		},
+		{"bad format", []string{"--format", "xml"}, nil, 2, ""},
+		{"too many arguments", []string{path, path}, nil, 2, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			var stdout bytes.Buffer
+			code := run(fs, tt.args, &stdout, io.Discard, 1, 2, length, tt.finish)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d", code, tt.wantCode)
+			}
+			if !strings.Contains(stdout.String(), tt.wantStdout) {
+				t.Errorf("stdout = %q, want it to contain %q", stdout.String(), tt.wantStdout)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Package search 提供与状态类型无关的图搜索：BFS、Dijkstra 和 A*。
+//
+// 状态可以是任意可比较的类型（坐标、坐标加朝向、若干机器人的位置组合……），
+// 调用方只需给出邻居函数以及（带权搜索时的）代价函数。搜索结果记录每个
+// 已到达状态的最短距离和前驱，可以据此重建路径，或在开启 AllPaths 时
+// 得到覆盖所有最短路径的前驱 DAG。
+package search
+
+import (
+	"container/heap"
+	"iter"
+)
+
+// Neighbors 枚举从状态 s 出发一步可以到达的状态。
+type Neighbors[S comparable] func(s S) iter.Seq[S]
+
+// Cost 返回从 from 走到相邻状态 to 的代价，必须非负。
+type Cost[S comparable] func(from, to S) int
+
+// Options 控制一次搜索的可选行为，零值表示完整地搜索所有可达状态。
+type Options[S comparable] struct {
+	// Goal 不为 nil 时，第一次取出满足 Goal 的状态后停止搜索（提前终止）。
+	Goal func(s S) bool
+	// Heuristic 不为 nil 时按 A* 搜索，返回 s 到目标代价的下界估计。
+	// 估计必须是一致的（单调的），否则结果可能不是最短路径。BFS 忽略此项。
+	Heuristic func(s S) int
+	// AllPaths 为 true 时记录每个状态在所有最短路径上的全部前驱，
+	// 否则只记录第一个找到的前驱。
+	AllPaths bool
+}
+
+// Result 是一次搜索的结果。
+type Result[S comparable] struct {
+	start S
+	nodes map[S]node[S]
+	// extra 记录 AllPaths 下除 node.prev 以外的等价前驱。分开存放可以让
+	// 常见的单前驱搜索不必为每个状态分配切片。
+	extra   map[S][]S
+	goal    S
+	reached bool
+}
+
+// node 是一个已到达状态的距离和第一个前驱（起点没有前驱）。
+type node[S comparable] struct {
+	dist int
+	prev S
+}
+
+func newResult[S comparable](start S) *Result[S] {
+	return &Result[S]{
+		start: start,
+		nodes: map[S]node[S]{start: {}},
+		extra: make(map[S][]S),
+	}
+}
+
+// relax 以代价 d 经由 from 到达 to，返回 to 的距离是否被缩短。
+func (r *Result[S]) relax(from, to S, d int, allPaths bool) bool {
+	old, seen := r.nodes[to]
+	switch {
+	case !seen || d < old.dist:
+		r.nodes[to] = node[S]{dist: d, prev: from}
+		if allPaths {
+			delete(r.extra, to)
+		}
+		return true
+	case d == old.dist && allPaths && to != r.start:
+		r.extra[to] = append(r.extra[to], from)
+	}
+	return false
+}
+
+// dist 返回一个已知已到达的状态的距离。
+func (r *Result[S]) dist(s S) int {
+	return r.nodes[s].dist
+}
+
+// Dist 返回从起点到 s 的最短距离；s 未被到达时返回 false。
+// 提前终止时，只有已被取出的状态的距离是最终结果。
+func (r *Result[S]) Dist(s S) (int, bool) {
+	n, ok := r.nodes[s]
+	return n.dist, ok
+}
+
+// Distances 返回所有已到达状态的距离表。返回的 map 归调用方所有。
+func (r *Result[S]) Distances() map[S]int {
+	out := make(map[S]int, len(r.nodes))
+	for s, n := range r.nodes {
+		out[s] = n.dist
+	}
+	return out
+}
+
+// Goal 返回触发提前终止的目标状态；没有设置 Goal 或目标不可达时返回 false。
+func (r *Result[S]) Goal() (S, bool) {
+	return r.goal, r.reached
+}
+
+// Predecessors 返回 s 在最短路径上的前驱。未开启 AllPaths 时最多一个。
+func (r *Result[S]) Predecessors(s S) []S {
+	n, ok := r.nodes[s]
+	if !ok || s == r.start {
+		return nil
+	}
+	return append([]S{n.prev}, r.extra[s]...)
+}
+
+// Path 沿第一个前驱回溯，返回从起点到 to 的一条最短路径（包含两端）。
+// to 未被到达时返回 nil。
+func (r *Result[S]) Path(to S) []S {
+	if _, ok := r.nodes[to]; !ok {
+		return nil
+	}
+	path := []S{to}
+	for cur := to; cur != r.start; {
+		cur = r.nodes[cur].prev
+		path = append(path, cur)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// OnShortestPaths 沿前驱 DAG 回溯，返回位于从起点到任一 targets 的最短路径上的
+// 所有状态（包括起点和 targets 本身）。未到达的 targets 会被忽略。
+// 只有开启 AllPaths 时才能得到全部最短路径，否则只覆盖 Path 给出的那一条。
+func (r *Result[S]) OnShortestPaths(targets ...S) map[S]bool {
+	on := make(map[S]bool)
+	var stack []S
+	for _, t := range targets {
+		if _, ok := r.nodes[t]; ok && !on[t] {
+			on[t] = true
+			stack = append(stack, t)
+		}
+	}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, p := range r.Predecessors(cur) {
+			if !on[p] {
+				on[p] = true
+				stack = append(stack, p)
+			}
+		}
+	}
+	return on
+}
+
+// BFS 从 start 开始做广度优先搜索，每一步的代价为 1。
+func BFS[S comparable](start S, neighbors Neighbors[S], opts Options[S]) *Result[S] {
+	r := newResult(start)
+	queue := []S{start}
+	for head := 0; head < len(queue); head++ {
+		cur := queue[head]
+		if opts.Goal != nil && opts.Goal(cur) {
+			r.goal, r.reached = cur, true
+			return r
+		}
+		d := r.dist(cur) + 1
+		for next := range neighbors(cur) {
+			if r.relax(cur, next, d, opts.AllPaths) {
+				queue = append(queue, next)
+			}
+		}
+	}
+	return r
+}
+
+// Dijkstra 从 start 开始按代价做最短路搜索；设置了 opts.Heuristic 时即为 A*。
+func Dijkstra[S comparable](start S, neighbors Neighbors[S], cost Cost[S], opts Options[S]) *Result[S] {
+	r := newResult(start)
+	priority := func(s S, d int) int {
+		if opts.Heuristic == nil {
+			return d
+		}
+		return d + opts.Heuristic(s)
+	}
+
+	pq := &queue[S]{{state: start, dist: 0, priority: priority(start, 0)}}
+	goalPriority, found := 0, false
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(item[S])
+		if cur.dist > r.dist(cur.state) {
+			continue // 过期的队列项，已有更短的距离
+		}
+		if found {
+			// 目标已找到：只继续处理与目标同优先级的状态，
+			// 以便在 AllPaths 下补全目标的等价前驱。
+			if cur.priority > goalPriority {
+				break
+			}
+		} else if opts.Goal != nil && opts.Goal(cur.state) {
+			r.goal, r.reached = cur.state, true
+			if !opts.AllPaths {
+				break
+			}
+			goalPriority, found = cur.priority, true
+			continue
+		}
+
+		for next := range neighbors(cur.state) {
+			d := cur.dist + cost(cur.state, next)
+			if r.relax(cur.state, next, d, opts.AllPaths) {
+				heap.Push(pq, item[S]{state: next, dist: d, priority: priority(next, d)})
+			}
+		}
+	}
+	return r
+}
+
+// AStar 是 Dijkstra 的便捷形式：带启发函数，并在到达 goal 时终止。
+func AStar[S comparable](start S, neighbors Neighbors[S], cost Cost[S], heuristic func(S) int, goal func(S) bool) *Result[S] {
+	return Dijkstra(start, neighbors, cost, Options[S]{Goal: goal, Heuristic: heuristic})
+}
+
+// item 是优先队列中的一项。
+type item[S any] struct {
+	state    S
+	dist     int
+	priority int
+}
+
+// queue 是按 priority 排序的最小堆。
+type queue[S any] []item[S]
+
+func (q queue[S]) Len() int           { return len(q) }
+func (q queue[S]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue[S]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *queue[S]) Push(x any) { *q = append(*q, x.(item[S])) }
+
+func (q *queue[S]) Pop() any {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	*q = old[:n-1]
+	return it
+}
@@ -0,0 +1,203 @@
+package search
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"adventofcode/grid"
+)
+
+// maze 是测试用的小迷宫：从 S 到 E 有上下两条路，上方的走廊更短。
+const maze = `
+#######
+#S...E#
+#.###.#
+#.....#
+#######
+`
+
+func mazeNeighbors(t *testing.T) (*grid.Grid[rune], Neighbors[grid.Point]) {
+	t.Helper()
+	g, err := grid.ParseRunes(maze)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g, func(p grid.Point) iter.Seq[grid.Point] {
+		return func(yield func(grid.Point) bool) {
+			for n := range g.Neighbors4(p) {
+				if g.At(n) != '#' && !yield(n) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func unitCost(_, _ grid.Point) int { return 1 }
+
+func TestBFS(t *testing.T) {
+	g, next := mazeNeighbors(t)
+	start, _ := grid.Locate(g, 'S')
+	end, _ := grid.Locate(g, 'E')
+
+	tests := []struct {
+		name      string
+		opts      Options[grid.Point]
+		wantDist  int
+		wantGoal  bool
+		wantCount int // 到达的状态数
+	}{
+		{name: "exhaustive", wantDist: 4, wantCount: 12},
+		{
+			name:     "early termination",
+			opts:     Options[grid.Point]{Goal: func(p grid.Point) bool { return p == end }},
+			wantDist: 4,
+			wantGoal: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := BFS(start, next, tt.opts)
+			if d, ok := r.Dist(end); !ok || d != tt.wantDist {
+				t.Errorf("Dist(E) = %d, %v; want %d", d, ok, tt.wantDist)
+			}
+			if got, ok := r.Goal(); ok != tt.wantGoal || (ok && got != end) {
+				t.Errorf("Goal() = %v, %v", got, ok)
+			}
+			if tt.wantCount != 0 && len(r.Distances()) != tt.wantCount {
+				t.Errorf("reached %d states, want %d", len(r.Distances()), tt.wantCount)
+			}
+			path := r.Path(end)
+			if len(path) != tt.wantDist+1 || path[0] != start || path[len(path)-1] != end {
+				t.Errorf("Path(E) = %v", path)
+			}
+		})
+	}
+}
+
+func TestUnreachable(t *testing.T) {
+	_, next := mazeNeighbors(t)
+	start := grid.Point{Row: 1, Col: 1}
+	wall := grid.Point{Row: 0, Col: 0}
+
+	r := Dijkstra(start, next, unitCost, Options[grid.Point]{Goal: func(p grid.Point) bool { return p == wall }})
+	if _, ok := r.Dist(wall); ok {
+		t.Error("wall should be unreachable")
+	}
+	if _, ok := r.Goal(); ok {
+		t.Error("Goal() reported success for an unreachable goal")
+	}
+	if p := r.Path(wall); p != nil {
+		t.Errorf("Path(wall) = %v, want nil", p)
+	}
+}
+
+func TestAllShortestPaths(t *testing.T) {
+	g, next := mazeNeighbors(t)
+	start, _ := grid.Locate(g, 'S')
+	end, _ := grid.Locate(g, 'E')
+
+	// 绕下方的路更长，因此只有上面一条最短路：S . . . E
+	single := Dijkstra(start, next, unitCost, Options[grid.Point]{AllPaths: true})
+	if got := len(single.OnShortestPaths(end)); got != 5 {
+		t.Errorf("tiles on shortest paths = %d, want 5", got)
+	}
+
+	// 让沿上方走廊的移动代价翻倍，使上下两条路等长（各 8）。
+	cost := func(from, to grid.Point) int {
+		if from.Row == 1 && to.Row == 1 {
+			return 2
+		}
+		return 1
+	}
+	both := Dijkstra(start, next, cost, Options[grid.Point]{AllPaths: true})
+	if d, _ := both.Dist(end); d != 8 {
+		t.Fatalf("Dist(E) = %d, want 8", d)
+	}
+	if got := len(both.OnShortestPaths(end)); got != 12 {
+		t.Errorf("tiles on shortest paths = %d, want 12", got)
+	}
+	if got := len(both.Predecessors(end)); got != 2 {
+		t.Errorf("E has %d predecessors, want 2", got)
+	}
+
+	// 不开启 AllPaths 时只保留一个前驱。
+	one := Dijkstra(start, next, cost, Options[grid.Point]{})
+	if got := len(one.Predecessors(end)); got != 1 {
+		t.Errorf("E has %d predecessors without AllPaths, want 1", got)
+	}
+
+	// 提前终止时也应补全目标的等价前驱。
+	early := Dijkstra(start, next, cost, Options[grid.Point]{
+		AllPaths: true,
+		Goal:     func(p grid.Point) bool { return p == end },
+	})
+	if got := len(early.Predecessors(end)); got != 2 {
+		t.Errorf("E has %d predecessors with early termination, want 2", got)
+	}
+}
+
+func TestAStar(t *testing.T) {
+	g, next := mazeNeighbors(t)
+	start, _ := grid.Locate(g, 'S')
+	end, _ := grid.Locate(g, 'E')
+
+	r := AStar(start, next, unitCost,
+		func(p grid.Point) int { return p.Manhattan(end) },
+		func(p grid.Point) bool { return p == end })
+
+	if got, ok := r.Goal(); !ok || got != end {
+		t.Fatalf("Goal() = %v, %v", got, ok)
+	}
+	if d, _ := r.Dist(end); d != 4 {
+		t.Errorf("Dist(E) = %d, want 4", d)
+	}
+	// 启发函数把搜索引向走廊，下方的绕路不应被完全展开。
+	if n := len(r.Distances()); n >= 12 {
+		t.Errorf("A* reached %d states, expected fewer than an exhaustive search", n)
+	}
+	want := []grid.Point{start, {Row: 1, Col: 2}, {Row: 1, Col: 3}, {Row: 1, Col: 4}, end}
+	if got := r.Path(end); !slices.Equal(got, want) {
+		t.Errorf("Path(E) = %v, want %v", got, want)
+	}
+}
+
+func TestWeightedStates(t *testing.T) {
+	// 状态是 (位置, 朝向) 的组合，转向代价很高：与 day16 相同的模型。
+	type state struct {
+		pos grid.Point
+		dir int
+	}
+	g, _ := mazeNeighbors(t)
+	start, _ := grid.Locate(g, 'S')
+	end, _ := grid.Locate(g, 'E')
+
+	next := func(s state) iter.Seq[state] {
+		return func(yield func(state) bool) {
+			if p := s.pos.Add(grid.Dirs4[s.dir]); g.At(p) != '#' && !yield(state{p, s.dir}) {
+				return
+			}
+			if !yield(state{s.pos, (s.dir + 1) % 4}) {
+				return
+			}
+			yield(state{s.pos, (s.dir + 3) % 4})
+		}
+	}
+	cost := func(from, to state) int {
+		if from.dir != to.dir {
+			return 1000
+		}
+		return 1
+	}
+
+	r := Dijkstra(state{start, 1}, next, cost, Options[state]{Goal: func(s state) bool { return s.pos == end }})
+	goal, ok := r.Goal()
+	if !ok {
+		t.Fatal("goal not reached")
+	}
+	if d, _ := r.Dist(goal); d != 4 {
+		t.Errorf("Dist = %d, want 4 (straight east, no turns)", d)
+	}
+}
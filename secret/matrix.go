@@ -0,0 +1,102 @@
+package secret
+
+import "math/bits"
+
+// Matrix 是 GF(2) 上的 24×24 矩阵，按列存储：第 j 列是第 j 位为 1 的
+// 输入映射到的值。矩阵作用于秘密数就是把输入中为 1 的位对应的列异或起来。
+type Matrix [Bits]uint32
+
+// Identity 返回单位矩阵。
+func Identity() Matrix {
+	var m Matrix
+	for j := range m {
+		m[j] = 1 << j
+	}
+	return m
+}
+
+// linear 返回线性映射 f 的矩阵。f 必须是 GF(2)^24 上的线性映射。
+func linear(f func(uint32) uint32) Matrix {
+	var m Matrix
+	for j := range m {
+		m[j] = f(1<<j) & Mask
+	}
+	return m
+}
+
+// Apply 返回 m·x。x 中超出 24 位的部分被忽略。
+func (m Matrix) Apply(x uint32) uint32 {
+	var y uint32
+	for x &= Mask; x != 0; x &= x - 1 {
+		y ^= m[bits.TrailingZeros32(x)]
+	}
+	return y
+}
+
+// Mul 返回 m·n，即先作用 n、再作用 m 的映射。
+func (m Matrix) Mul(n Matrix) Matrix {
+	var p Matrix
+	for j := range p {
+		p[j] = m.Apply(n[j])
+	}
+	return p
+}
+
+// Pow 用反复平方计算 m^k，需要 O(log k) 次矩阵乘法。
+func (m Matrix) Pow(k uint64) Matrix {
+	p := Identity()
+	for ; k > 0; k >>= 1 {
+		if k&1 == 1 {
+			p = p.Mul(m)
+		}
+		m = m.Mul(m)
+	}
+	return p
+}
+
+// Row 返回 m 的第 i 行，即输出第 i 位依赖的输入位。
+func (m Matrix) Row(i int) uint32 {
+	var r uint32
+	for j, col := range m {
+		r |= (col >> i & 1) << j
+	}
+	return r
+}
+
+// Inverse 用高斯–若尔当消元求 m 的逆矩阵；m 不可逆时返回 false。
+func (m Matrix) Inverse() (Matrix, bool) {
+	// 把 m 的各行和单位矩阵一起做行消元：m 化成单位矩阵时，另一半就是逆矩阵的各行。
+	var rows, inv [Bits]uint32
+	for i := range rows {
+		rows[i] = m.Row(i)
+		inv[i] = 1 << i
+	}
+	for col := range Bits {
+		pivot := -1
+		for r := col; r < Bits; r++ {
+			if rows[r]>>col&1 == 1 {
+				pivot = r
+				break
+			}
+		}
+		if pivot < 0 {
+			return Matrix{}, false
+		}
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+		inv[col], inv[pivot] = inv[pivot], inv[col]
+		for r := range Bits {
+			if r != col && rows[r]>>col&1 == 1 {
+				rows[r] ^= rows[col]
+				inv[r] ^= inv[col]
+			}
+		}
+	}
+	// inv 现在按行存放逆矩阵，转回按列存储。
+	var out Matrix
+	for i, row := range inv {
+		for j := range out {
+			out[j] |= (row >> j & 1) << i
+		}
+	}
+	return out, true
+}
@@ -0,0 +1,113 @@
+package secret
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+// Recover 返回所有价格序列以 prices 开头的 24 位初始秘密数，按从小到大排列。
+// prices[0] 是初始秘密数的价格（个位数），prices[k] 是它之后第 k 个秘密数的价格。
+//
+// 价格的奇偶性就是秘密数的最低位，而第 k 个秘密数的最低位是初始秘密数各位的
+// 线性组合（Step^k 的第 0 行），所以每个价格给出 GF(2) 上的一个方程。先消元
+// 解出满足所有奇偶方程的候选，再逐个检查完整的价格。24 个左右的价格通常就能
+// 唯一确定初始秘密数。
+func Recover(prices []int) ([]int, error) {
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("secret: no prices to recover from")
+	}
+	for k, p := range prices {
+		if p < 0 || p > 9 {
+			return nil, fmt.Errorf("secret: price %d at position %d is not a digit", p, k)
+		}
+	}
+
+	sys, ok := solveParity(prices)
+	if !ok {
+		return nil, nil
+	}
+	var found []int
+	for s := range sys.solutions {
+		if matches(int(s), prices) {
+			found = append(found, int(s))
+		}
+	}
+	slices.Sort(found)
+	return found, nil
+}
+
+// parity 是化简后的奇偶方程组：每个方程是 rows[i]·s = rhs[i]，
+// 主元位 pivots[i] 只出现在第 i 个方程中。
+type parity struct {
+	rows, rhs, pivots []uint32
+	free              uint32 // 不是主元的位，可以任意取值
+}
+
+// solveParity 把每个价格的奇偶性化成关于初始秘密数的线性方程并消元；方程组矛盾时返回 false。
+func solveParity(prices []int) (parity, bool) {
+	var sys parity
+	m := Identity()
+	for _, p := range prices {
+		row, rhs := m.Row(0), uint32(p&1)
+		m = Step.Mul(m)
+		for i, pivot := range sys.pivots {
+			if row&pivot != 0 {
+				row ^= sys.rows[i]
+				rhs ^= sys.rhs[i]
+			}
+		}
+		if row == 0 {
+			if rhs != 0 {
+				return parity{}, false
+			}
+			continue
+		}
+		pivot := row & -row
+		for i := range sys.rows {
+			if sys.rows[i]&pivot != 0 {
+				sys.rows[i] ^= row
+				sys.rhs[i] ^= rhs
+			}
+		}
+		sys.rows = append(sys.rows, row)
+		sys.rhs = append(sys.rhs, rhs)
+		sys.pivots = append(sys.pivots, pivot)
+		if len(sys.pivots) == Bits {
+			break
+		}
+	}
+	sys.free = Mask
+	for _, pivot := range sys.pivots {
+		sys.free &^= pivot
+	}
+	return sys, true
+}
+
+// solutions 依次给出方程组的所有解：自由位取遍所有组合，主元位由方程确定。
+func (sys parity) solutions(yield func(uint32) bool) {
+	for a := uint32(0); ; a = (a - sys.free) & sys.free {
+		s := a
+		for i, row := range sys.rows {
+			if uint32(bits.OnesCount32(row&a)&1)^sys.rhs[i] == 1 {
+				s |= sys.pivots[i]
+			}
+		}
+		if !yield(s) || a == sys.free {
+			return
+		}
+	}
+}
+
+// matches 报告从 s 开始的价格序列是否以 prices 开头。
+func matches(s int, prices []int) bool {
+	for k, p := range prices {
+		if k > 0 {
+			s = Next(s)
+		}
+		if s%10 != p {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+// Package secret 实现第 22 天猴市买家的秘密数生成器，以及基于它的线性结构的运算。
+//
+// 生成器的每一步（乘 64、除以 32、乘 2048，各自混合后修剪到 24 位）都是
+// GF(2)^24 上的可逆线性映射，所以整步可以写成一个 24×24 的矩阵 Step。
+// 借助矩阵的幂和逆，Advance 可以在 O(log n) 时间内前进或后退 n 步，
+// Recover 可以从观察到的价格序列解出可能的初始秘密数。
+package secret
+
+import (
+	"iter"
+	"math/bits"
+	"sync"
+)
+
+const (
+	// Bits 是秘密数修剪后保留的位数。
+	Bits = 24
+	// Modulus 是修剪时取模的数 16777216。
+	Modulus = 1 << Bits
+	// Mask 取出秘密数的低 24 位。
+	Mask = Modulus - 1
+)
+
+// Next 按谜题的描述计算下一个秘密数。s 必须非负。
+func Next(s int) int {
+	s = (s ^ s*64) % Modulus
+	s = (s ^ s/32) % Modulus
+	s = (s ^ s*2048) % Modulus
+	return s
+}
+
+// Step 是 Next 在 GF(2)^24 上的矩阵。
+var Step = linear(func(x uint32) uint32 { return uint32(Next(int(x))) })
+
+// jumps 是前进和后退 2^k 步的矩阵表，第一次使用时计算。表有 UintSize 项，
+// 因为 n 为 math.MinInt 时 -n 是 2^(UintSize-1)。
+var jumps = sync.OnceValue(func() (t [2][bits.UintSize]Matrix) {
+	back, ok := Step.Inverse()
+	if !ok {
+		panic("secret: the step matrix is singular")
+	}
+	t[0][0], t[1][0] = Step, back
+	for k := 1; k < len(t[0]); k++ {
+		t[0][k] = t[0][k-1].Mul(t[0][k-1])
+		t[1][k] = t[1][k-1].Mul(t[1][k-1])
+	}
+	return t
+})
+
+// Jump 返回前进 n 步的矩阵；n 为负数时返回后退 -n 步的矩阵。
+// 需要对很多秘密数跳过同样的步数时，先取得矩阵再逐个 Apply 更快。
+func Jump(n int) Matrix {
+	m := Identity()
+	for p := range powers(n) {
+		m = p.Mul(m)
+	}
+	return m
+}
+
+// Advance 返回 s 之后第 n 个秘密数，只需要 O(log n) 次矩阵作用；
+// n 为负数时返回之前第 -n 个。s 必须非负，只有它的低 24 位起作用。
+func Advance(s, n int) int {
+	x := uint32(s) & Mask
+	for p := range powers(n) {
+		x = p.Apply(x)
+	}
+	return int(x)
+}
+
+// powers 依次产生组成前进 n 步的各个 2^k 步矩阵，即 |n| 的每个为 1 的位
+// 对应的一项。这些矩阵都是 Step 的幂，可以按任意顺序作用。
+func powers(n int) iter.Seq[Matrix] {
+	return func(yield func(Matrix) bool) {
+		t := jumps()
+		dir, k := 0, uint(n)
+		if n < 0 {
+			dir, k = 1, -uint(n)
+		}
+		for i := 0; k != 0; i, k = i+1, k>>1 {
+			if k&1 == 1 && !yield(t[dir][i]) {
+				return
+			}
+		}
+	}
+}
+
+// Prev 返回 24 位范围内唯一一个下一个秘密数为 s 的秘密数。
+func Prev(s int) int {
+	return Advance(s, -1)
+}
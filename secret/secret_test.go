@@ -0,0 +1,223 @@
+package secret
+
+import (
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// nextSecret 是独立于 Next 和 Step 的参照实现：用移位、异或和掩码
+// 逐条执行谜题描述的三个步骤。
+func nextSecret(s int) int {
+	s = (s ^ s<<6) & Mask  // 乘 64，混合，修剪
+	s = (s ^ s>>5) & Mask  // 除以 32，混合，修剪
+	s = (s ^ s<<11) & Mask // 乘 2048，混合，修剪
+	return s
+}
+
+// iterate 逐步调用 nextSecret，是 Advance 和 Jump 的参照实现。
+func iterate(s, n int) int {
+	for range n {
+		s = nextSecret(s)
+	}
+	return s
+}
+
+func TestNext(t *testing.T) {
+	// 谜题中从 123 开始的十个秘密数。
+	want := []int{
+		15887950, 16495136, 527345, 704524, 1553684,
+		12683156, 11100544, 12249484, 7753432, 5908254,
+	}
+	s := 123
+	for i, w := range want {
+		s = Next(s)
+		if s != w {
+			t.Errorf("step %d: got %d, want %d", i+1, s, w)
+		}
+	}
+}
+
+func TestStepMatchesNext(t *testing.T) {
+	rng := rand.New(rand.NewPCG(22, 24))
+	for range 10000 {
+		s := rng.IntN(Modulus)
+		want := nextSecret(s)
+		if got := Next(s); got != want {
+			t.Fatalf("Next(%d) = %d, want %d", s, got, want)
+		}
+		if got := int(Step.Apply(uint32(s))); got != want {
+			t.Fatalf("Step.Apply(%d) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	rng := rand.New(rand.NewPCG(22, 1))
+	for range 200 {
+		s, n := rng.IntN(Modulus), rng.IntN(3000)
+		want := iterate(s, n)
+		if got := Advance(s, n); got != want {
+			t.Fatalf("Advance(%d, %d) = %d, want %d", s, n, got, want)
+		}
+		if got := int(Jump(n).Apply(uint32(s))); got != want {
+			t.Fatalf("Jump(%d).Apply(%d) = %d, want %d", n, s, got, want)
+		}
+		if got := Advance(want, -n); got != s {
+			t.Fatalf("Advance(%d, %d) = %d, want %d", want, -n, got, s)
+		}
+		if got := Prev(nextSecret(s)); got != s {
+			t.Fatalf("Prev(Next(%d)) = %d", s, got)
+		}
+	}
+
+	// 大步数：跳转可以拆开，也可以一步退回。
+	for range 50 {
+		s := rng.IntN(Modulus)
+		a, b := rng.Int64N(1<<62), rng.Int64N(1<<62)
+		if got, want := Advance(s, int(a+b)), Advance(Advance(s, int(a)), int(b)); got != want {
+			t.Fatalf("Advance(%d, %d+%d) = %d, want %d", s, a, b, got, want)
+		}
+		if got := Advance(Advance(s, int(a)), -int(a)); got != s {
+			t.Fatalf("Advance back by %d gives %d, want %d", a, got, s)
+		}
+	}
+
+	// 步数的两端：MinInt 的绝对值超出 int 的范围。
+	for _, n := range []int{math.MinInt, math.MinInt + 1, math.MaxInt} {
+		s := rng.IntN(Modulus)
+		got := Advance(s, n)
+		if want := int(Jump(n).Apply(uint32(s))); got != want {
+			t.Errorf("Advance(%d, %d) = %d, Jump gives %d", s, n, got, want)
+		}
+		if n != math.MinInt {
+			if back := Advance(got, -n); back != s {
+				t.Errorf("Advance(Advance(%d, %d), %d) = %d", s, n, -n, back)
+			}
+		}
+	}
+	if got, want := Advance(5, math.MinInt), Advance(Advance(5, math.MinInt+1), -1); got != want {
+		t.Errorf("Advance(5, MinInt) = %d, want %d", got, want)
+	}
+	if got, want := Advance(5, math.MaxInt), Advance(Advance(5, math.MaxInt-1), 1); got != want {
+		t.Errorf("Advance(5, MaxInt) = %d, want %d", got, want)
+	}
+
+	// 第 2000 个秘密数：谜题第一部分的例子。
+	for s, want := range map[int]int{1: 8685429, 10: 4700978, 100: 15273692, 2024: 8667524} {
+		if got := Advance(s, 2000); got != want {
+			t.Errorf("Advance(%d, 2000) = %d, want %d", s, got, want)
+		}
+	}
+	if got := Advance(Modulus+5, 0); got != 5 {
+		t.Errorf("Advance(%d, 0) = %d, want 5", Modulus+5, got)
+	}
+	if got, want := Advance(Modulus+5, 1), nextSecret(5); got != want {
+		t.Errorf("Advance(%d, 1) = %d, want %d", Modulus+5, got, want)
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 7))
+	for range 100 {
+		var m Matrix
+		for j := range m {
+			m[j] = rng.Uint32() & Mask
+		}
+		inv, ok := m.Inverse()
+		if !ok {
+			continue // 随机矩阵可能不可逆
+		}
+		if m.Mul(inv) != Identity() || inv.Mul(m) != Identity() {
+			t.Fatalf("Inverse of %v is wrong", m)
+		}
+	}
+
+	singular := Identity()
+	singular[5] = singular[3] ^ singular[7]
+	if _, ok := singular.Inverse(); ok {
+		t.Error("Inverse of a singular matrix: want false")
+	}
+
+	if Step.Pow(0) != Identity() {
+		t.Error("Step^0 is not the identity")
+	}
+	if got, want := Step.Pow(1000), Jump(1000); got != want {
+		t.Error("Step.Pow(1000) differs from Jump(1000)")
+	}
+	x := rng.Uint32() & Mask
+	for i := range Bits {
+		got := bits.OnesCount32(Step.Row(i)&x)%2 == 1
+		if want := Step.Apply(x)>>i&1 == 1; got != want {
+			t.Errorf("Row(%d) disagrees with Apply", i)
+		}
+	}
+}
+
+// prices 返回从 s 开始的 n 个价格。
+func prices(s, n int) []int {
+	p := make([]int, n)
+	for k := range p {
+		p[k] = s % 10
+		s = Next(s)
+	}
+	return p
+}
+
+func TestRecover(t *testing.T) {
+	rng := rand.New(rand.NewPCG(22, 22))
+	for range 20 {
+		s := rng.IntN(Modulus)
+		got, err := Recover(prices(s, 40))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, []int{s}) {
+			t.Errorf("Recover(prices of %d) = %v", s, got)
+		}
+	}
+
+	// 价格少时有多个候选，与穷举的结果比较。
+	s := rng.IntN(Modulus)
+	observed := prices(s, 7)
+	got, err := Recover(observed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []int
+	for c := range Modulus {
+		if c%10 == observed[0] && slices.Equal(prices(c, len(observed)), observed) {
+			want = append(want, c)
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Recover(%v) found %d candidates, brute force %d", observed, len(got), len(want))
+	}
+	if !slices.Contains(got, s) {
+		t.Errorf("Recover(%v) misses %d", observed, s)
+	}
+
+	// 0 之后永远是 0，全为 0 的价格只能来自它。
+	zero, err := Recover(make([]int, 30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(zero, []int{0}) {
+		t.Errorf("Recover(all zeros) = %v, want [0]", zero)
+	}
+
+	// 改变最后一个价格的奇偶性，序列就不可能出现。
+	observed = prices(s, 30)
+	observed[29] ^= 1
+	if got, err := Recover(observed); err != nil || len(got) != 0 {
+		t.Errorf("Recover of an impossible sequence = %v, %v; want no candidates", got, err)
+	}
+
+	for _, bad := range [][]int{nil, {1, 10}, {-1}} {
+		if _, err := Recover(bad); err == nil {
+			t.Errorf("Recover(%v): want error", bad)
+		}
+	}
+}
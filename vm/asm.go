@@ -0,0 +1,152 @@
+package vm
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"adventofcode/parse"
+)
+
+// Comment 以伪代码描述指令的效果，组合操作数写作寄存器名，例如 "C = A >> B"。
+func (in Instruction) Comment() string {
+	operand := strconv.Itoa(in.Operand)
+	if in.Op.Kind() == Combo {
+		operand = ComboName(in.Operand)
+	}
+	switch in.Op {
+	case Adv:
+		return "A = A >> " + operand
+	case Bxl:
+		return "B = B ^ " + operand
+	case Bst:
+		return "B = " + operand + " % 8"
+	case Jnz:
+		return "if A != 0 goto " + operand
+	case Bxc:
+		return "B = B ^ C"
+	case Out:
+		return "output " + operand + " % 8"
+	case Bdv:
+		return "B = A >> " + operand
+	case Cdv:
+		return "C = A >> " + operand
+	}
+	return ""
+}
+
+// Disassemble 把程序写成汇编清单，每条指令一行：地址、汇编形式和伪代码注释，
+//
+//	0  bst A    ; B = A % 8
+//
+// 程序长度为奇数时，最后一个缺少操作数的值写成原始数据 ".word 5"。
+// 输出可以被 Assemble 原样读回。
+func Disassemble(w io.Writer, program []int) error {
+	for ip := 0; ip < len(program); ip += 2 {
+		in, ok := Decode(program, ip)
+		var err error
+		if ok {
+			_, err = fmt.Fprintf(w, "%3d  %-7s  ; %s\n", ip, in, in.Comment())
+		} else {
+			word := fmt.Sprintf(".word %d", program[ip])
+			_, err = fmt.Fprintf(w, "%3d  %-7s  ; missing operand, halts here\n", ip, word)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Assemble 把汇编文本翻译成程序。每行一条指令，形如 "bst A" 或 "jnz 0"：
+// 组合操作数可以写成 0–3、A、B、C（保留的 7 写作 7 或 ?7），其他指令的操作数
+// 是 0–7，bxc 的操作数可以省略（取 0）。";" 或 "#" 之后是注释；行首可以有
+// Disassemble 输出的地址，但它必须与指令的实际位置一致。".word v" 原样写入
+// 一个 0–7 的值，用来表示奇数长度程序末尾的值。
+func Assemble(text string) ([]int, error) {
+	var program []int
+	for lineNo, line := range parse.Lines(text) {
+		if i := strings.IndexAny(line, ";#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := parse.Fields(line, nil)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if _, err := strconv.Atoi(fields[0].Text); err == nil {
+			addr, _ := fields[0].Int(lineNo)
+			if addr != len(program) {
+				return nil, parse.Errorf(lineNo, fields[0].Col, "address %d does not match instruction position %d", addr, len(program))
+			}
+			fields = fields[1:]
+			if len(fields) == 0 {
+				continue
+			}
+		}
+
+		if fields[0].Text == ".word" {
+			if len(fields) != 2 {
+				return nil, parse.Errorf(lineNo, fields[0].Col, ".word needs exactly one value")
+			}
+			v, err := strconv.Atoi(fields[1].Text)
+			if err != nil || v < 0 || v > 7 {
+				return nil, parse.Errorf(lineNo, fields[1].Col, "invalid .word value %q: want 0-7", fields[1].Text)
+			}
+			program = append(program, v)
+			continue
+		}
+
+		op, ok := ParseOpcode(fields[0].Text)
+		if !ok {
+			return nil, parse.Errorf(lineNo, fields[0].Col, "unknown instruction %q", fields[0].Text)
+		}
+		operand := 0
+		switch {
+		case len(fields) > 2:
+			return nil, parse.Errorf(lineNo, fields[2].Col, "unexpected %q after the operand", fields[2].Text)
+		case len(fields) == 2:
+			var err error
+			if operand, err = parseOperand(op, fields[1].Text); err != nil {
+				return nil, parse.Errorf(lineNo, fields[1].Col, "%v: %v", op, err)
+			}
+		case op.Kind() != Ignored:
+			return nil, parse.Errorf(lineNo, len(line)+1, "%v needs an operand", op)
+		}
+		program = append(program, int(op), operand)
+	}
+	if program == nil {
+		return nil, fmt.Errorf("no instructions")
+	}
+	return program, nil
+}
+
+// parseOperand 按 op 的操作数类型解析操作数。
+func parseOperand(op Opcode, s string) (int, error) {
+	if op.Kind() == Combo {
+		switch strings.ToUpper(s) {
+		case "A":
+			return 4, nil
+		case "B":
+			return 5, nil
+		case "C":
+			return 6, nil
+		case "?7":
+			return 7, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 0 || v > 7 {
+		if op.Kind() == Combo {
+			return 0, fmt.Errorf("invalid combo operand %q: want 0-3, A, B or C", s)
+		}
+		return 0, fmt.Errorf("invalid operand %q: want 0-7", s)
+	}
+	return v, nil
+}
+
+// FormatProgram 返回谜题输入中的程序行，例如 "Program: 0,3,5,4,3,0"。
+func FormatProgram(program []int) string {
+	return "Program: " + Join(program)
+}
@@ -0,0 +1,98 @@
+package vm
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"adventofcode/parse"
+)
+
+func TestDisassembleRoundTrip(t *testing.T) {
+	programs := [][]int{
+		{2, 4, 1, 3, 7, 5, 0, 3, 1, 5, 4, 4, 5, 5, 3, 0},
+		{0, 3, 5, 4, 3, 0},
+		{6, 7, 7, 6, 4, 0},    // 包含保留的组合操作数 7
+		{2, 4, 7, 5, 3, 0, 5}, // 奇数长度，最后一个值缺少操作数
+		{1},
+	}
+	for _, program := range programs {
+		var listing strings.Builder
+		if err := Disassemble(&listing, program); err != nil {
+			t.Fatal(err)
+		}
+		got, err := Assemble(listing.String())
+		if err != nil {
+			t.Fatalf("Assemble(%q): %v", listing.String(), err)
+		}
+		if !slices.Equal(got, program) {
+			t.Errorf("round trip of %v gave %v\n%s", program, got, listing.String())
+		}
+	}
+}
+
+func TestDisassemble(t *testing.T) {
+	var listing strings.Builder
+	if err := Disassemble(&listing, []int{2, 4, 7, 5, 3, 0, 5}); err != nil {
+		t.Fatal(err)
+	}
+	want := "" +
+		"  0  bst A    ; B = A % 8\n" +
+		"  2  cdv B    ; C = A >> B\n" +
+		"  4  jnz 0    ; if A != 0 goto 0\n" +
+		"  6  .word 5  ; missing operand, halts here\n"
+	if listing.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", listing.String(), want)
+	}
+}
+
+func TestAssemble(t *testing.T) {
+	const src = `
+# 谜题第二部分的例子
+adv 3
+OUT a   ; 大小写均可
+jnz 0
+bxc
+`
+	got, err := Assemble(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 3, 5, 4, 3, 0, 4, 0}; !slices.Equal(got, want) {
+		t.Errorf("Assemble() = %v, want %v", got, want)
+	}
+	if s := FormatProgram(got); s != "Program: 0,3,5,4,3,0,4,0" {
+		t.Errorf("FormatProgram() = %q", s)
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	tests := []struct {
+		src       string
+		line, col int
+	}{
+		{"adv 3\nmul 2\n", 2, 1},
+		{"adv 3\nbxl A\n", 2, 5},
+		{"adv 8\n", 1, 5},
+		{"out\n", 1, 4},
+		{"adv 3 4\n", 1, 7},
+		{"0 adv 3\n4 out A\n", 2, 1},
+		{"adv 3\n.word 8\n", 2, 7},
+		{".word\n", 1, 1},
+	}
+	for _, tt := range tests {
+		_, err := Assemble(tt.src)
+		var perr *parse.Error
+		if !errors.As(err, &perr) {
+			t.Errorf("Assemble(%q) = %v, want a parse error", tt.src, err)
+			continue
+		}
+		if perr.Line != tt.line || perr.Col != tt.col {
+			t.Errorf("Assemble(%q) error at %d:%d, want %d:%d (%v)", tt.src, perr.Line, perr.Col, tt.line, tt.col, err)
+		}
+	}
+	if _, err := Assemble("; nothing\n"); err == nil {
+		t.Error("Assemble() of an empty listing succeeded")
+	}
+}
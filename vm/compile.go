@@ -0,0 +1,151 @@
+package vm
+
+// Compiled 是预先解码、编译成 Go 闭包链的程序，适合用大量不同的初始寄存器
+// 反复运行（例如暴力搜索 A）：运行时不再解码指令或判断操作数类型。
+// 它的行为与 Machine 完全一致，包括停机条件、组合操作数 7 和负移位数的错误以及 Budget。
+type Compiled struct {
+	Program []int
+	// Budget 是每次运行最多执行的指令条数，0 表示不限。
+	Budget int
+	// steps[ip] 是从 ip 处解码的指令；跳转目标可以是奇数，所以每个 ip 都编译。
+	// ip >= len(steps) 表示停机。
+	steps []step
+}
+
+// registers 是一次运行的状态。
+type registers struct {
+	a, b, c int
+	out     []int
+	err     error
+}
+
+// step 执行一条指令并返回下一条指令的地址；出错时把错误存入 r.err。
+type step func(r *registers) int
+
+// Compile 编译程序。program 中的每个值都必须在 0–7 之间。
+func Compile(program []int) (*Compiled, error) {
+	if _, err := New(program, 0, 0, 0); err != nil {
+		return nil, err
+	}
+	p := &Compiled{Program: program}
+	for ip := 0; ip+1 < len(program); ip++ {
+		in, _ := Decode(program, ip)
+		p.steps = append(p.steps, compileInstruction(ip, in))
+	}
+	return p, nil
+}
+
+// compileInstruction 把 ip 处的指令 in 编译成闭包。组合操作数在编译时
+// 解析为取寄存器或常量的函数，常见的字面值移位直接除以常量。
+func compileInstruction(ip int, in Instruction) step {
+	next := ip + 2
+	literal := in.Operand
+
+	var combo func(r *registers) int
+	if in.Op.Kind() == Combo {
+		switch in.Operand {
+		case 4:
+			combo = func(r *registers) int { return r.a }
+		case 5:
+			combo = func(r *registers) int { return r.b }
+		case 6:
+			combo = func(r *registers) int { return r.c }
+		case 7:
+			err := &OperandError{IP: ip, Instruction: in}
+			return func(r *registers) int {
+				r.err = err
+				return ip
+			}
+		default:
+			combo = func(*registers) int { return literal }
+		}
+	}
+	constShift := in.Op.Kind() == Combo && in.Operand < 4
+	divisor := 1 << literal
+	// shiftA 计算 A 右移组合操作数位；操作数为负时把 *ShiftError 存入 r.err，
+	// 与 Machine.Step 一样不改变寄存器。
+	shiftA := func(r *registers) (int, bool) {
+		n := combo(r)
+		if n < 0 {
+			r.err = &ShiftError{IP: ip, Instruction: in, Shift: n}
+			return 0, false
+		}
+		return shift(r.a, n), true
+	}
+
+	switch in.Op {
+	case Adv:
+		if constShift {
+			return func(r *registers) int { r.a /= divisor; return next }
+		}
+		return func(r *registers) int {
+			v, ok := shiftA(r)
+			if !ok {
+				return ip
+			}
+			r.a = v
+			return next
+		}
+	case Bxl:
+		return func(r *registers) int { r.b ^= literal; return next }
+	case Bst:
+		return func(r *registers) int { r.b = combo(r) & 7; return next }
+	case Jnz:
+		return func(r *registers) int {
+			if r.a != 0 {
+				return literal
+			}
+			return next
+		}
+	case Bxc:
+		return func(r *registers) int { r.b ^= r.c; return next }
+	case Out:
+		return func(r *registers) int { r.out = append(r.out, combo(r)&7); return next }
+	case Bdv:
+		if constShift {
+			return func(r *registers) int { r.b = r.a / divisor; return next }
+		}
+		return func(r *registers) int {
+			v, ok := shiftA(r)
+			if !ok {
+				return ip
+			}
+			r.b = v
+			return next
+		}
+	default: // Cdv
+		if constShift {
+			return func(r *registers) int { r.c = r.a / divisor; return next }
+		}
+		return func(r *registers) int {
+			v, ok := shiftA(r)
+			if !ok {
+				return ip
+			}
+			r.c = v
+			return next
+		}
+	}
+}
+
+// Run 以给定的初始寄存器运行程序到停机，返回全部输出。
+// 出错或达到 Budget 时返回已产生的输出和错误，与 Machine.Run 相同。
+func (p *Compiled) Run(a, b, c int) ([]int, error) {
+	return p.AppendOutput(nil, a, b, c)
+}
+
+// AppendOutput 与 Run 相同，但把输出追加到 dst 后返回，
+// 反复运行时可以复用同一块内存：p.AppendOutput(buf[:0], a, b, c)。
+func (p *Compiled) AppendOutput(dst []int, a, b, c int) ([]int, error) {
+	r := registers{a: a, b: b, c: c, out: dst}
+	for ip, executed := 0, 0; ip < len(p.steps); executed++ {
+		if p.Budget > 0 && executed >= p.Budget {
+			return r.out, ErrBudget
+		}
+		ip = p.steps[ip](&r)
+		if r.err != nil {
+			return r.out, r.err
+		}
+	}
+	return r.out, nil
+}
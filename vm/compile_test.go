@@ -0,0 +1,169 @@
+package vm
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// reference 是按谜题描述逐字实现的解释器，用来和 Machine、Compiled 对照。
+// 出错时返回 "operand"、"shift" 或 "budget"。
+func reference(program []int, a, b, c, budget int) (output []int, fault string) {
+	pow2 := func(n int) int {
+		if n >= 63 {
+			return 0 // 除数超出 int 范围，商为 0
+		}
+		return 1 << n
+	}
+	div := func(n int) int {
+		if d := pow2(n); d != 0 {
+			return a / d
+		}
+		return 0
+	}
+	for ip, steps := 0, 0; ip+1 < len(program); steps++ {
+		if steps == budget {
+			return output, "budget"
+		}
+		opcode, operand := program[ip], program[ip+1]
+		combo := operand
+		switch operand {
+		case 4:
+			combo = a
+		case 5:
+			combo = b
+		case 6:
+			combo = c
+		case 7:
+			if opcode != 1 && opcode != 3 && opcode != 4 {
+				return output, "operand"
+			}
+		}
+		if (opcode == 0 || opcode == 6 || opcode == 7) && combo < 0 {
+			return output, "shift" // 2 的负数次幂没有定义
+		}
+		ip += 2
+		switch opcode {
+		case 0:
+			a = div(combo)
+		case 1:
+			b ^= operand
+		case 2:
+			b = mod8(combo)
+		case 3:
+			if a != 0 {
+				ip = operand
+			}
+		case 4:
+			b ^= c
+		case 5:
+			output = append(output, mod8(combo))
+		case 6:
+			b = div(combo)
+		case 7:
+			c = div(combo)
+		}
+	}
+	return output, ""
+}
+
+// mod8 返回 x 除以 8 的非负余数，负的寄存器值也落在 0–7 之间。
+func mod8(x int) int {
+	return (x%8 + 8) % 8
+}
+
+// fault 把 Machine 和 Compiled 返回的错误归类，便于和 reference 比较。
+func fault(err error) string {
+	var opErr *OperandError
+	var shiftErr *ShiftError
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &opErr):
+		return "operand"
+	case errors.As(err, &shiftErr):
+		return "shift"
+	case errors.Is(err, ErrBudget):
+		return "budget"
+	}
+	return err.Error()
+}
+
+func TestCompiledMatchesReference(t *testing.T) {
+	const budget = 500
+	rng := rand.New(rand.NewPCG(17, 2024))
+
+	programs := [][]int{
+		{2, 4, 1, 3, 7, 5, 0, 3, 1, 5, 4, 4, 5, 5, 3, 0},
+		{0, 3, 5, 4, 3, 0},
+		{0, 1, 5, 4, 3, 0},
+	}
+	for range 2000 {
+		program := make([]int, 1+rng.IntN(16))
+		for i := range program {
+			program[i] = rng.IntN(8)
+		}
+		programs = append(programs, program)
+	}
+
+	for _, program := range programs {
+		compiled, err := Compile(program)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compiled.Budget = budget
+		var buf []int
+		for range 8 {
+			// B、C 有时为负，覆盖负移位数的错误
+			a, b, c := rng.IntN(1<<48), rng.IntN(64)-16, rng.IntN(1<<20)-1<<18
+
+			wantOut, wantFault := reference(program, a, b, c, budget)
+
+			m, _ := New(program, a, b, c)
+			m.Budget = budget
+			gotOut, err := m.Run()
+			if !slices.Equal(gotOut, wantOut) || fault(err) != wantFault {
+				t.Fatalf("Machine on %v with A=%d B=%d C=%d: %v, %v; reference %v, %q", program, a, b, c, gotOut, err, wantOut, wantFault)
+			}
+
+			buf, err = compiled.AppendOutput(buf[:0], a, b, c)
+			if !slices.Equal(buf, wantOut) || fault(err) != wantFault {
+				t.Fatalf("Compiled on %v with A=%d B=%d C=%d: %v, %v; reference %v, %q", program, a, b, c, buf, err, wantOut, wantFault)
+			}
+		}
+	}
+}
+
+func TestCompile(t *testing.T) {
+	if _, err := Compile([]int{0, 9}); err == nil {
+		t.Error("Compile() with a non-3-bit value succeeded")
+	}
+
+	p, err := Compile([]int{0, 1, 5, 4, 3, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 同一个 Compiled 可以用不同的寄存器反复运行
+	for _, tt := range []struct {
+		a    int
+		want string
+	}{{729, "4,6,3,5,6,3,5,2,1,0"}, {2024, "4,2,5,6,7,7,7,7,3,1,0"}, {0, "0"}} {
+		out, err := p.Run(tt.a, 0, 0)
+		if err != nil || Join(out) != tt.want {
+			t.Errorf("Run(%d) = %v, %v, want %s", tt.a, out, err, tt.want)
+		}
+	}
+
+	p, _ = Compile([]int{0, 5})
+	var shiftErr *ShiftError
+	if _, err := p.Run(1, -2, 0); !errors.As(err, &shiftErr) || shiftErr.IP != 0 || shiftErr.Shift != -2 {
+		t.Errorf("Run() with B = -2 = %v, want *ShiftError at ip 0", err)
+	}
+
+	p, _ = Compile([]int{3, 0})
+	p.Budget = 10
+	if _, err := p.Run(1, 0, 0); !errors.Is(err, ErrBudget) {
+		t.Errorf("Run() of an endless loop = %v, want ErrBudget", err)
+	}
+}
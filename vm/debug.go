@@ -0,0 +1,166 @@
+package vm
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Condition 是对寄存器取值的条件，例如 "A == 0" 或 "ip >= 8"。
+type Condition struct {
+	Reg   string // "A"、"B"、"C" 或 "ip"
+	Op    string // "=="、"!="、"<"、"<="、">"、">="
+	Value int
+}
+
+// conditionOps 按先长后短的顺序排列，使 "<=" 不会被当作 "<"。
+var conditionOps = []string{"==", "!=", "<=", ">=", "<", ">", "="}
+
+// ParseCondition 解析 "寄存器 比较符 整数" 形式的条件，空格可有可无，
+// 寄存器不区分大小写，"=" 等同于 "=="。
+func ParseCondition(s string) (Condition, error) {
+	for _, op := range conditionOps {
+		i := strings.Index(s, op)
+		if i < 0 {
+			continue
+		}
+		c := Condition{Reg: strings.TrimSpace(s[:i]), Op: op}
+		if c.Op == "=" {
+			c.Op = "=="
+		}
+		switch strings.ToUpper(c.Reg) {
+		case "A", "B", "C":
+			c.Reg = strings.ToUpper(c.Reg)
+		case "IP":
+			c.Reg = "ip"
+		default:
+			return Condition{}, fmt.Errorf("condition %q: unknown register %q: want A, B, C or ip", s, c.Reg)
+		}
+		v, err := strconv.Atoi(strings.TrimSpace(s[i+len(op):]))
+		if err != nil {
+			return Condition{}, fmt.Errorf("condition %q: value is not an integer", s)
+		}
+		c.Value = v
+		return c, nil
+	}
+	return Condition{}, fmt.Errorf("condition %q: want <register> <op> <value>, e.g. A==0", s)
+}
+
+func (c Condition) String() string {
+	return fmt.Sprintf("%s %s %d", c.Reg, c.Op, c.Value)
+}
+
+// Holds 报告 m 当前的状态是否满足条件。
+func (c Condition) Holds(m *Machine) bool {
+	var v int
+	switch c.Reg {
+	case "A":
+		v = m.A
+	case "B":
+		v = m.B
+	case "C":
+		v = m.C
+	case "ip":
+		v = m.IP
+	}
+	switch c.Op {
+	case "==":
+		return v == c.Value
+	case "!=":
+		return v != c.Value
+	case "<":
+		return v < c.Value
+	case "<=":
+		return v <= c.Value
+	case ">":
+		return v > c.Value
+	case ">=":
+		return v >= c.Value
+	}
+	return false
+}
+
+// State 返回寄存器和 IP 的当前值，例如 "ip=2 A=729 B=1 C=0"。
+func (m *Machine) State() string {
+	return fmt.Sprintf("ip=%d A=%d B=%d C=%d", m.IP, m.A, m.B, m.C)
+}
+
+// StopReason 说明 Debugger.Continue 为什么停下。
+type StopReason int
+
+const (
+	Halted     StopReason = iota // 机器停机
+	Breakpoint                   // 下一条指令的地址是断点
+	Watch                        // 某个条件成立
+)
+
+func (r StopReason) String() string {
+	switch r {
+	case Halted:
+		return "halted"
+	case Breakpoint:
+		return "breakpoint"
+	case Watch:
+		return "condition"
+	}
+	return fmt.Sprintf("StopReason(%d)", int(r))
+}
+
+// Debugger 在 Machine 上增加断点、条件和逐条指令的跟踪。
+type Debugger struct {
+	*Machine
+	// Breakpoints 是断点地址：即将执行这些地址上的指令时停下。
+	Breakpoints []int
+	// Conditions 在每条指令执行后检查，任何一个成立时停下。
+	Conditions []Condition
+	// Trace 不为 nil 时，每执行一条指令写一行：地址、指令、执行后的寄存器和输出。
+	Trace io.Writer
+	// Output 是迄今为止的全部输出。
+	Output []int
+}
+
+// Step 执行一条指令，并在设置了 Trace 时写出跟踪行。
+func (d *Debugger) Step() error {
+	ip := d.IP
+	in, _ := d.Next()
+	out, emitted, err := d.Machine.Step()
+	if err != nil {
+		return err
+	}
+	if emitted {
+		d.Output = append(d.Output, out)
+	}
+	if d.Trace != nil {
+		line := fmt.Sprintf("%3d  %-7s  A=%d B=%d C=%d", ip, in, d.A, d.B, d.C)
+		if emitted {
+			line += fmt.Sprintf("  out %d", out)
+		}
+		if _, err := fmt.Fprintln(d.Trace, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Continue 至少执行一条指令，然后一直运行到停机、遇到断点或某个条件成立。
+// 因条件停下时返回成立的条件。
+func (d *Debugger) Continue() (StopReason, *Condition, error) {
+	for {
+		if err := d.Step(); err != nil {
+			return 0, nil, err
+		}
+		for i, c := range d.Conditions {
+			if c.Holds(d.Machine) {
+				return Watch, &d.Conditions[i], nil
+			}
+		}
+		if d.Halted() {
+			return Halted, nil, nil
+		}
+		if slices.Contains(d.Breakpoints, d.IP) {
+			return Breakpoint, nil, nil
+		}
+	}
+}
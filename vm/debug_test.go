@@ -0,0 +1,66 @@
+package vm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Condition
+		wantErr bool
+	}{
+		{in: "A==0", want: Condition{"A", "==", 0}},
+		{in: "b >= 7", want: Condition{"B", ">=", 7}},
+		{in: "ip=12", want: Condition{"ip", "==", 12}},
+		{in: "C<=-1", want: Condition{"C", "<=", -1}},
+		{in: "C != 3", want: Condition{"C", "!=", 3}},
+		{in: "D==1", wantErr: true},
+		{in: "A==x", wantErr: true},
+		{in: "A", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseCondition(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseCondition(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseCondition(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDebugger(t *testing.T) {
+	m, _ := New([]int{0, 1, 5, 4, 3, 0}, 729, 0, 0)
+	var trace strings.Builder
+	d := &Debugger{Machine: m, Breakpoints: []int{4}, Trace: &trace}
+
+	reason, _, err := d.Continue()
+	if err != nil || reason != Breakpoint || d.IP != 4 {
+		t.Fatalf("Continue() = %v, %v at %s, want breakpoint at ip 4", reason, err, d.State())
+	}
+	if want := "  0  adv 1    A=364 B=0 C=0\n  2  out A    A=364 B=0 C=0  out 4\n"; trace.String() != want {
+		t.Errorf("trace = %q, want %q", trace.String(), want)
+	}
+
+	// 从断点处继续时先执行断点上的指令，否则会原地不动
+	d.Breakpoints = nil
+	d.Conditions = []Condition{{"A", "<", 10}}
+	reason, cond, err := d.Continue()
+	if err != nil || reason != Watch || cond == nil || d.A >= 10 {
+		t.Fatalf("Continue() = %v, %v, %v at %s, want the condition to stop it", reason, cond, err, d.State())
+	}
+
+	d.Conditions = nil
+	if reason, _, err = d.Continue(); err != nil || reason != Halted {
+		t.Fatalf("Continue() = %v, %v, want halted", reason, err)
+	}
+	if got := Join(d.Output); got != "4,6,3,5,6,3,5,2,1,0" {
+		t.Errorf("output = %s", got)
+	}
+	if _, _, err := d.Continue(); err != ErrHalted {
+		t.Errorf("Continue() after halting = %v, want ErrHalted", err)
+	}
+}
@@ -0,0 +1,103 @@
+package vm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Opcode 是 3 位计算机的指令操作码。
+type Opcode int
+
+// 八条指令，取值即操作码。
+const (
+	Adv Opcode = iota // A = A >> combo
+	Bxl               // B = B ^ literal
+	Bst               // B = combo % 8
+	Jnz               // A != 0 时跳转到 literal
+	Bxc               // B = B ^ C（忽略操作数）
+	Out               // 输出 combo % 8
+	Bdv               // B = A >> combo
+	Cdv               // C = A >> combo
+)
+
+var mnemonics = [...]string{"adv", "bxl", "bst", "jnz", "bxc", "out", "bdv", "cdv"}
+
+func (op Opcode) String() string {
+	if op < 0 || int(op) >= len(mnemonics) {
+		return fmt.Sprintf("Opcode(%d)", int(op))
+	}
+	return mnemonics[op]
+}
+
+// ParseOpcode 返回助记符对应的操作码，不区分大小写。
+func ParseOpcode(s string) (Opcode, bool) {
+	for i, m := range mnemonics {
+		if strings.EqualFold(s, m) {
+			return Opcode(i), true
+		}
+	}
+	return 0, false
+}
+
+// OperandKind 描述指令如何解释它的操作数。
+type OperandKind int
+
+const (
+	Literal OperandKind = iota // 操作数就是它自身的值
+	Combo                      // 0–3 是字面值，4、5、6 分别是寄存器 A、B、C，7 保留
+	Ignored                    // 操作数被读取但不使用
+)
+
+// Kind 返回 op 的操作数类型。
+func (op Opcode) Kind() OperandKind {
+	switch op {
+	case Bxl, Jnz:
+		return Literal
+	case Bxc:
+		return Ignored
+	}
+	return Combo
+}
+
+// shifts 报告 op 是否把 A 右移组合操作数位。
+func (op Opcode) shifts() bool {
+	return op == Adv || op == Bdv || op == Cdv
+}
+
+// Instruction 是一条解码后的指令。
+type Instruction struct {
+	Op      Opcode
+	Operand int
+}
+
+// Decode 解码程序中 ip 处的指令；ip 处没有完整的指令（越界或缺少操作数）时返回 false，
+// 此时计算机停机。
+func Decode(program []int, ip int) (Instruction, bool) {
+	if ip < 0 || ip+1 >= len(program) {
+		return Instruction{}, false
+	}
+	return Instruction{Op: Opcode(program[ip]), Operand: program[ip+1]}, true
+}
+
+// String 以汇编形式返回指令，组合操作数 4–6 写作寄存器名，例如 "bst A"、"jnz 0"。
+func (in Instruction) String() string {
+	if in.Op.Kind() == Combo {
+		return fmt.Sprintf("%s %s", in.Op, ComboName(in.Operand))
+	}
+	return fmt.Sprintf("%s %d", in.Op, in.Operand)
+}
+
+// ComboName 返回组合操作数的写法：0–3 为数字，4–6 为寄存器名，7 为 "?7"。
+func ComboName(operand int) string {
+	switch operand {
+	case 4:
+		return "A"
+	case 5:
+		return "B"
+	case 6:
+		return "C"
+	case 7:
+		return "?7"
+	}
+	return fmt.Sprint(operand)
+}
@@ -0,0 +1,148 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	// ErrUnsupported 表示程序不是 FindQuine 能处理的循环结构。
+	ErrUnsupported = errors.New("vm: unsupported program shape")
+	// ErrNoQuine 表示不存在使程序输出自身的 A。
+	ErrNoQuine = errors.New("vm: no initial A makes the program output itself")
+)
+
+// FindQuine 返回使程序输出自身的最小正初始 A，B、C 为给定的初始值。
+//
+// 程序必须是这样的循环：以 "jnz 0" 结尾，循环体中没有其他跳转，恰好有一条
+// "adv 3" 和一条 out，并且 B、C 在循环体内先写后读。这样每一轮消耗 A 的最低
+// 3 位（一个八进制位）并输出一个值，而输出只取决于 A 尚未被移走的高位。
+// 于是可以从最高的八进制位开始倒着搜索：A 的高 k 位确定以后，程序的输出必须
+// 恰好是程序的最后 k 个值；每个候选都在虚拟机上实际运行来检验。
+//
+// 程序不是这种结构时返回包装了 ErrUnsupported 的错误，找不到解时返回 ErrNoQuine。
+func FindQuine(program []int, b, c int) (int, error) {
+	if _, err := New(program, 0, b, c); err != nil {
+		return 0, err
+	}
+	if err := checkLoopShape(program); err != nil {
+		return 0, err
+	}
+	if 3*len(program) >= strconv.IntSize {
+		return 0, fmt.Errorf("%w: %d outputs need more than %d bits of A", ErrUnsupported, len(program), strconv.IntSize-1)
+	}
+
+	// 每轮执行 len(program)/2 条指令，最多 len(program) 轮（多一轮即输出过长）。
+	budget := (len(program) + 1) * len(program) / 2
+
+	// matches 报告以 a 为初始值运行时，输出是否恰好是程序的后缀 program[k:]。
+	matches := func(a, k int) (bool, error) {
+		m := &Machine{A: a, B: b, C: c, Program: program, Budget: budget}
+		want := program[k:]
+		n := 0
+		for !m.Halted() {
+			out, emitted, err := m.Step()
+			if err != nil {
+				return false, err
+			}
+			if !emitted {
+				continue
+			}
+			if n >= len(want) || out != want[n] {
+				return false, nil
+			}
+			n++
+		}
+		return n == len(want), nil
+	}
+
+	// search 已经确定了 A 的高位 high（对应程序的后缀 program[k+1:]），
+	// 按从小到大的顺序尝试下一个八进制位。所有解的位数相同且最高位非零，
+	// 因此深度优先找到的第一个解就是最小的。
+	var search func(high, k int) (int, bool, error)
+	search = func(high, k int) (int, bool, error) {
+		if k < 0 {
+			return high, high > 0, nil
+		}
+		for d := range 8 {
+			a := high<<3 | d
+			ok, err := matches(a, k)
+			if err != nil {
+				return 0, false, fmt.Errorf("A=%d: %w", a, err)
+			}
+			if !ok {
+				continue
+			}
+			if found, ok, err := search(a, k-1); err != nil || ok {
+				return found, ok, err
+			}
+		}
+		return 0, false, nil
+	}
+
+	a, ok, err := search(0, len(program)-1)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrNoQuine
+	}
+	return a, nil
+}
+
+// checkLoopShape 检查程序是否符合 FindQuine 要求的循环结构。
+func checkLoopShape(program []int) error {
+	if len(program) == 0 || len(program)%2 != 0 {
+		return fmt.Errorf("%w: program length %d is not a whole number of instructions", ErrUnsupported, len(program))
+	}
+	last := len(program) - 2
+	if in, _ := Decode(program, last); in != (Instruction{Jnz, 0}) {
+		return fmt.Errorf("%w: last instruction is %v, want jnz 0", ErrUnsupported, in)
+	}
+
+	var advs, outs int
+	written := map[int]bool{4: true} // 已在本轮写过的组合操作数；A 由上一轮留下
+	for ip := 0; ip < last; ip += 2 {
+		in, _ := Decode(program, ip)
+		reads := []int{}
+		if in.Op.Kind() == Combo {
+			if in.Operand == 7 {
+				return &OperandError{IP: ip, Instruction: in}
+			}
+			reads = append(reads, in.Operand)
+		}
+		var writes int
+		switch in.Op {
+		case Adv:
+			advs++
+			if in.Operand != 3 {
+				return fmt.Errorf("%w: ip %d: %v, want adv 3", ErrUnsupported, ip, in)
+			}
+		case Jnz:
+			return fmt.Errorf("%w: ip %d: jump inside the loop body", ErrUnsupported, ip)
+		case Out:
+			outs++
+		case Bxl:
+			reads, writes = append(reads, 5), 5
+		case Bxc:
+			reads, writes = append(reads, 5, 6), 5
+		case Bst, Bdv:
+			writes = 5
+		case Cdv:
+			writes = 6
+		}
+		for _, r := range reads {
+			if r >= 4 && !written[r] {
+				return fmt.Errorf("%w: ip %d: %v reads %s before it is written in the loop", ErrUnsupported, ip, in, ComboName(r))
+			}
+		}
+		if writes != 0 {
+			written[writes] = true
+		}
+	}
+	if advs != 1 || outs != 1 {
+		return fmt.Errorf("%w: loop has %d adv and %d out instructions, want exactly one of each", ErrUnsupported, advs, outs)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Package vm 实现第 17 天谜题中的 3 位计算机：三个整数寄存器 A、B、C，
+// 由 0–7 组成的程序，以及 adv、bxl、bst、jnz、bxc、out、bdv、cdv 八条指令。
+//
+// Machine 可以单步执行（Step），也可以一直运行到停机（Run），或者在
+// 单独的 goroutine 中运行并通过通道逐个取得输出（Stream）。Budget 限制
+// 执行的指令条数，用来防止死循环的程序卡住调用方。
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrHalted 表示机器已经停机，不能继续执行。
+	ErrHalted = errors.New("vm: machine has halted")
+	// ErrBudget 表示执行的指令条数达到了 Budget。
+	ErrBudget = errors.New("vm: instruction budget exhausted")
+)
+
+// OperandError 表示指令使用了非法的组合操作数 7。
+type OperandError struct {
+	IP          int
+	Instruction Instruction
+}
+
+func (e *OperandError) Error() string {
+	return fmt.Sprintf("vm: ip %d: %v uses the reserved combo operand 7", e.IP, e.Instruction)
+}
+
+// ShiftError 表示 adv、bdv 或 cdv 的组合操作数是负数，无法作为 2 的指数。
+// 只有寄存器中出现负数时才会发生。
+type ShiftError struct {
+	IP          int
+	Instruction Instruction
+	Shift       int
+}
+
+func (e *ShiftError) Error() string {
+	return fmt.Sprintf("vm: ip %d: %v shifts by negative amount %d", e.IP, e.Instruction, e.Shift)
+}
+
+// Machine 是一台 3 位计算机。零值不可用，请用 New 创建。
+type Machine struct {
+	A, B, C int
+	IP      int
+	Program []int
+	// Budget 是最多执行的指令条数，0 表示不限。
+	Budget int
+	// Executed 是已经执行的指令条数。
+	Executed int
+}
+
+// New 返回一台装载了 program、寄存器为给定初始值的机器。
+// program 中的每个值都必须在 0–7 之间。
+func New(program []int, a, b, c int) (*Machine, error) {
+	for i, v := range program {
+		if v < 0 || v > 7 {
+			return nil, fmt.Errorf("vm: program value %d at index %d is not a 3-bit number", v, i)
+		}
+	}
+	return &Machine{A: a, B: b, C: c, Program: program}, nil
+}
+
+// Halted 报告机器是否已停机，即 IP 处没有完整的指令。
+func (m *Machine) Halted() bool {
+	_, ok := Decode(m.Program, m.IP)
+	return !ok
+}
+
+// Next 返回下一条将要执行的指令；机器已停机时返回 false。
+func (m *Machine) Next() (Instruction, bool) {
+	return Decode(m.Program, m.IP)
+}
+
+// combo 返回组合操作数的值。
+func (m *Machine) combo(operand int) (int, bool) {
+	switch operand {
+	case 0, 1, 2, 3:
+		return operand, true
+	case 4:
+		return m.A, true
+	case 5:
+		return m.B, true
+	case 6:
+		return m.C, true
+	}
+	return 0, false
+}
+
+// Step 执行一条指令。指令是 out 时返回输出值和 true。
+// 机器已停机时返回 ErrHalted，达到 Budget 时返回 ErrBudget，操作数非法时返回
+// *OperandError 或 *ShiftError，此时机器状态不变。
+func (m *Machine) Step() (out int, emitted bool, err error) {
+	in, ok := m.Next()
+	if !ok {
+		return 0, false, ErrHalted
+	}
+	if m.Budget > 0 && m.Executed >= m.Budget {
+		return 0, false, ErrBudget
+	}
+
+	var value int
+	switch in.Op.Kind() {
+	case Literal:
+		value = in.Operand
+	case Combo:
+		if value, ok = m.combo(in.Operand); !ok {
+			return 0, false, &OperandError{IP: m.IP, Instruction: in}
+		}
+		if in.Op.shifts() && value < 0 {
+			return 0, false, &ShiftError{IP: m.IP, Instruction: in, Shift: value}
+		}
+	}
+
+	m.Executed++
+	m.IP += 2
+	switch in.Op {
+	case Adv:
+		m.A = shift(m.A, value)
+	case Bxl:
+		m.B ^= value
+	case Bst:
+		m.B = value & 7
+	case Jnz:
+		if m.A != 0 {
+			m.IP = value
+		}
+	case Bxc:
+		m.B ^= m.C
+	case Out:
+		return value & 7, true, nil
+	case Bdv:
+		m.B = shift(m.A, value)
+	case Cdv:
+		m.C = shift(m.A, value)
+	}
+	return 0, false, nil
+}
+
+// shift 计算 a / 2^n（向零取整），n 必须非负。n 超过整数位数时结果为 0。
+func shift(a, n int) int {
+	if n >= strconv.IntSize-1 {
+		return 0
+	}
+	return a / (1 << n)
+}
+
+// Run 一直执行到停机，返回所有输出值。出错或达到 Budget 时返回已产生的输出和错误。
+func (m *Machine) Run() ([]int, error) {
+	var output []int
+	for !m.Halted() {
+		out, emitted, err := m.Step()
+		if err != nil {
+			return output, err
+		}
+		if emitted {
+			output = append(output, out)
+		}
+	}
+	return output, nil
+}
+
+// Stream 在新的 goroutine 中运行机器，把每个输出值依次发送到返回的通道。
+// 机器停机、出错、达到 Budget 或 ctx 被取消后通道关闭，之后调用返回的函数
+// 可以取得结束的原因（正常停机时为 nil）。运行期间不要访问机器的字段。
+func (m *Machine) Stream(ctx context.Context) (<-chan int, func() error) {
+	ch := make(chan int)
+	var err error
+	go func() {
+		defer close(ch)
+		for !m.Halted() {
+			out, emitted, stepErr := m.Step()
+			if stepErr != nil {
+				err = stepErr
+				return
+			}
+			if !emitted {
+				continue
+			}
+			select {
+			case ch <- out:
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			}
+		}
+	}()
+	// 通道关闭发生在 err 写入之后，读完通道再调用是安全的。
+	return ch, func() error { return err }
+}
+
+// Join 把输出值用逗号连接，即谜题要求的答案格式。
+func Join(output []int) string {
+	parts := make([]string, len(output))
+	for i, v := range output {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ",")
+}
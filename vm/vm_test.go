@@ -0,0 +1,185 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	// 谜题描述中的各个小例子
+	tests := []struct {
+		name       string
+		a, b, c    int
+		program    []int
+		wantOutput []int
+		wantA      int
+		wantB      int
+		wantC      int
+	}{
+		{name: "bst C", c: 9, program: []int{2, 6}, wantB: 1, wantC: 9},
+		{name: "out", a: 10, program: []int{5, 0, 5, 1, 5, 4}, wantOutput: []int{0, 1, 2}, wantA: 10},
+		{
+			name:       "adv loop",
+			a:          2024,
+			program:    []int{0, 1, 5, 4, 3, 0},
+			wantOutput: []int{4, 2, 5, 6, 7, 7, 7, 7, 3, 1, 0},
+			wantA:      0,
+		},
+		{name: "bxl", b: 29, program: []int{1, 7}, wantB: 26},
+		{name: "bxc", b: 2024, c: 43690, program: []int{4, 0}, wantB: 44354, wantC: 43690},
+		{name: "bdv cdv", a: 100, program: []int{6, 2, 7, 3}, wantA: 100, wantB: 25, wantC: 12},
+		{
+			name:       "example",
+			a:          729,
+			program:    []int{0, 1, 5, 4, 3, 0},
+			wantOutput: []int{4, 6, 3, 5, 6, 3, 5, 2, 1, 0},
+		},
+		{name: "odd length halts before the missing operand", a: 3, program: []int{5, 4, 5}, wantOutput: []int{3}, wantA: 3},
+		{name: "huge shift", a: 1 << 40, b: 70, program: []int{0, 5}, wantB: 70},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := New(tt.program, tt.a, tt.b, tt.c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := m.Run()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(out, tt.wantOutput) {
+				t.Errorf("output = %v, want %v", out, tt.wantOutput)
+			}
+			if m.A != tt.wantA || m.B != tt.wantB || m.C != tt.wantC {
+				t.Errorf("registers = %d,%d,%d, want %d,%d,%d", m.A, m.B, m.C, tt.wantA, tt.wantB, tt.wantC)
+			}
+			if !m.Halted() {
+				t.Error("machine has not halted")
+			}
+		})
+	}
+}
+
+func TestStep(t *testing.T) {
+	m, err := New([]int{0, 1, 5, 4, 3, 0}, 2, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type event struct {
+		ip, out int
+		emitted bool
+	}
+	var got []event
+	for !m.Halted() {
+		ip := m.IP
+		out, emitted, err := m.Step()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, event{ip, out, emitted})
+	}
+	want := []event{
+		{0, 0, false}, {2, 1, true}, {4, 0, false}, // A: 2 -> 1，输出 1，跳回 0
+		{0, 0, false}, {2, 0, true}, {4, 0, false}, // A: 1 -> 0，输出 0，不跳转
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("steps = %v, want %v", got, want)
+	}
+	if m.Executed != 6 {
+		t.Errorf("Executed = %d, want 6", m.Executed)
+	}
+	if _, _, err := m.Step(); !errors.Is(err, ErrHalted) {
+		t.Errorf("Step() after halting = %v, want ErrHalted", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := New([]int{0, 8}, 0, 0, 0); err == nil {
+		t.Error("New() with a non-3-bit value succeeded")
+	}
+
+	m, _ := New([]int{5, 7}, 0, 0, 0)
+	var opErr *OperandError
+	if _, _, err := m.Step(); !errors.As(err, &opErr) || opErr.IP != 0 {
+		t.Errorf("Step() = %v, want *OperandError at ip 0", err)
+	}
+	if m.IP != 0 || m.Executed != 0 {
+		t.Errorf("state changed after an invalid instruction: ip %d, executed %d", m.IP, m.Executed)
+	}
+
+	// 负的寄存器不能作为移位数
+	m, _ = New([]int{6, 5, 5, 5}, 8, -1, 0)
+	var shiftErr *ShiftError
+	if _, err := m.Run(); !errors.As(err, &shiftErr) || shiftErr.IP != 0 || shiftErr.Shift != -1 {
+		t.Errorf("Run() with B = -1 = %v, want *ShiftError at ip 0", err)
+	}
+	if m.IP != 0 || m.Executed != 0 || m.B != -1 {
+		t.Errorf("state changed after a negative shift: ip %d, executed %d, B %d", m.IP, m.Executed, m.B)
+	}
+
+	m, _ = New([]int{3, 0}, 1, 0, 0) // 死循环
+	m.Budget = 100
+	if _, err := m.Run(); !errors.Is(err, ErrBudget) {
+		t.Errorf("Run() = %v, want ErrBudget", err)
+	}
+	if m.Executed != 100 {
+		t.Errorf("Executed = %d, want 100", m.Executed)
+	}
+}
+
+func TestStream(t *testing.T) {
+	m, _ := New([]int{0, 1, 5, 4, 3, 0}, 2024, 0, 0)
+	ch, wait := m.Stream(context.Background())
+	var got []int
+	for v := range ch {
+		got = append(got, v)
+	}
+	if err := wait(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{4, 2, 5, 6, 7, 7, 7, 7, 3, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf("streamed %v, want %v", got, want)
+	}
+
+	// 取消后通道关闭，结束原因为 ctx 的错误
+	ctx, cancel := context.WithCancel(context.Background())
+	m, _ = New([]int{5, 4, 3, 0}, 1, 0, 0) // 不停地输出 1
+	ch, wait = m.Stream(ctx)
+	<-ch
+	cancel()
+	for range ch {
+	}
+	if err := wait(); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait() = %v, want context.Canceled", err)
+	}
+}
+
+func TestInstructionString(t *testing.T) {
+	tests := []struct {
+		in   Instruction
+		want string
+	}{
+		{Instruction{Bst, 4}, "bst A"},
+		{Instruction{Cdv, 5}, "cdv B"},
+		{Instruction{Adv, 3}, "adv 3"},
+		{Instruction{Bxl, 6}, "bxl 6"},
+		{Instruction{Jnz, 0}, "jnz 0"},
+		{Instruction{Bxc, 1}, "bxc 1"},
+		{Instruction{Out, 7}, "out ?7"},
+	}
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("%#v.String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+	if op, ok := ParseOpcode("CDV"); !ok || op != Cdv {
+		t.Errorf("ParseOpcode(CDV) = %v, %v", op, ok)
+	}
+	if Join([]int{4, 6, 3}) != "4,6,3" || Join(nil) != "" {
+		t.Error("Join mismatch")
+	}
+}